@@ -8,6 +8,7 @@ package format
 import (
 	"fmt"
 	"net/url"
+	"strings"
 	stdtime "time"
 
 	"google.golang.org/protobuf/proto"
@@ -265,7 +266,8 @@ func FromProto(container *pb.CloudEvent) (*event.Event, error) {
 			vs, _ := v.(types.Timestamp)
 			e.SetTime(vs.Time)
 		default:
-			e.SetExtension(name, v)
+			// Lower cased, like the names read by the other formats
+			e.SetExtension(strings.ToLower(name), v)
 		}
 	}
 	return &e, nil
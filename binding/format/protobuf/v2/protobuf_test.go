@@ -30,10 +30,10 @@ func TestProtobufFormatWithoutProtobufCodec(t *testing.T) {
 	e.SetExtension(test, test)
 	e.SetExtension("int", 1)
 	e.SetExtension("bool", true)
-	e.SetExtension("URI", &url.URL{
+	e.SetExtension("uri", &url.URL{
 		Host: "test-uri",
 	})
-	e.SetExtension("URIRef", types.URIRef{URL: url.URL{
+	e.SetExtension("uriref", types.URIRef{URL: url.URL{
 		Host: "test-uriref",
 	}})
 	e.SetExtension("bytes", []byte(test))
@@ -60,10 +60,10 @@ func TestProtobufFormatWithProtobufCodec(t *testing.T) {
 	e.SetExtension(test, test)
 	e.SetExtension("int", 1)
 	e.SetExtension("bool", true)
-	e.SetExtension("URI", &url.URL{
+	e.SetExtension("uri", &url.URL{
 		Host: "test-uri",
 	})
-	e.SetExtension("URIRef", types.URIRef{URL: url.URL{
+	e.SetExtension("uriref", types.URIRef{URL: url.URL{
 		Host: "test-uriref",
 	}})
 	e.SetExtension("bytes", []byte(test))
@@ -25,8 +25,10 @@ MODULES=(
   "protocol/pubsub"
   "protocol/kafka_sarama"
   "protocol/ws"
+  "protocol/redis"
   "observability/opencensus"
   "observability/opentelemetry"
+  "observability/otelconv"
   "sql"
   "binding/format/protobuf"
 )
@@ -44,8 +46,10 @@ REPOINT_SAMPLES=(
   "github.com/cloudevents/sdk-go/protocol/pubsub/v2"
   "github.com/cloudevents/sdk-go/protocol/kafka_sarama/v2"
   "github.com/cloudevents/sdk-go/protocol/ws/v2"
+  "github.com/cloudevents/sdk-go/protocol/redis/v2"
   "github.com/cloudevents/sdk-go/observability/opencensus/v2"
   "github.com/cloudevents/sdk-go/observability/opentelemetry/v2"
+  "github.com/cloudevents/sdk-go/observability/otelconv/v2"
   "github.com/cloudevents/sdk-go/sql/v2"
   "github.com/cloudevents/sdk-go/binding/format/protobuf/v2"
   "github.com/cloudevents/sdk-go/v2"                       # NOTE: this needs to be last.
@@ -0,0 +1,10 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+/*
+Package otelconv implements conversions from CloudEvents to OpenTelemetry signals.
+It lives in its own module, so the OpenTelemetry dependencies are required only when it's used.
+*/
+package otelconv
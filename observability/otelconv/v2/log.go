@@ -0,0 +1,140 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package otelconv
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/otel/log"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/observability"
+	"github.com/cloudevents/sdk-go/v2/types"
+)
+
+// ExtensionAttrPrefix is the prefix of the attribute keys holding the event extensions.
+const ExtensionAttrPrefix = "cloudevents."
+
+// ToLogRecord converts the event to an OpenTelemetry log record:
+//
+//   - the event time is used as the record timestamp
+//   - the event attributes are mapped to the record attributes, using the keys defined in the
+//     observability package, while extensions are mapped using the ExtensionAttrPrefix
+//   - the event data is used as the record body: JSON data is converted to the equivalent structured value,
+//     text data to a string value, and any other data to a bytes value
+func ToLogRecord(e event.Event) log.Record {
+	var r log.Record
+	if t := e.Time(); !t.IsZero() {
+		r.SetTimestamp(t)
+	}
+	r.AddAttributes(attributes(e)...)
+	if body, ok := dataValue(e); ok {
+		r.SetBody(body)
+	}
+	return r
+}
+
+func attributes(e event.Event) []log.KeyValue {
+	attrs := []log.KeyValue{
+		log.String(observability.SpecversionAttr, e.SpecVersion()),
+		log.String(observability.IdAttr, e.ID()),
+		log.String(observability.TypeAttr, e.Type()),
+		log.String(observability.SourceAttr, e.Source()),
+	}
+	if sub := e.Subject(); sub != "" {
+		attrs = append(attrs, log.String(observability.SubjectAttr, sub))
+	}
+	if dct := e.DataContentType(); dct != "" {
+		attrs = append(attrs, log.String(observability.DatacontenttypeAttr, dct))
+	}
+
+	exts := e.Extensions()
+	names := make([]string, 0, len(exts))
+	for name := range exts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		attrs = append(attrs, log.KeyValue{Key: ExtensionAttrPrefix + name, Value: extensionValue(exts[name])})
+	}
+	return attrs
+}
+
+func extensionValue(v interface{}) log.Value {
+	switch v := v.(type) {
+	case bool:
+		return log.BoolValue(v)
+	case int32:
+		return log.Int64Value(int64(v))
+	case []byte:
+		return log.BytesValue(v)
+	}
+	s, err := types.Format(v)
+	if err != nil {
+		s = ""
+	}
+	return log.StringValue(s)
+}
+
+func dataValue(e event.Event) (log.Value, bool) {
+	data := e.Data()
+	if len(data) == 0 {
+		return log.Value{}, false
+	}
+
+	mt := e.DataMediaType()
+	switch {
+	case mt == "" || mt == event.ApplicationJSON || mt == event.TextJSON || strings.HasSuffix(mt, "+json"):
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.UseNumber()
+		var v interface{}
+		if err := dec.Decode(&v); err == nil {
+			return jsonValue(v), true
+		}
+		return log.StringValue(string(data)), true
+	case strings.HasPrefix(mt, "text/"):
+		return log.StringValue(string(data)), true
+	}
+	return log.BytesValue(data), true
+}
+
+func jsonValue(v interface{}) log.Value {
+	switch v := v.(type) {
+	case string:
+		return log.StringValue(v)
+	case bool:
+		return log.BoolValue(v)
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return log.Int64Value(i)
+		}
+		if f, err := v.Float64(); err == nil {
+			return log.Float64Value(f)
+		}
+		return log.StringValue(v.String())
+	case []interface{}:
+		vals := make([]log.Value, len(v))
+		for i, item := range v {
+			vals[i] = jsonValue(item)
+		}
+		return log.SliceValue(vals...)
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		kvs := make([]log.KeyValue, len(keys))
+		for i, k := range keys {
+			kvs[i] = log.KeyValue{Key: k, Value: jsonValue(v[k])}
+		}
+		return log.MapValue(kvs...)
+	}
+	return log.Value{}
+}
@@ -0,0 +1,102 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package otelconv
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/log"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/observability"
+)
+
+func recordAttributes(r log.Record) map[string]log.Value {
+	attrs := map[string]log.Value{}
+	r.WalkAttributes(func(kv log.KeyValue) bool {
+		attrs[kv.Key] = kv.Value
+		return true
+	})
+	return attrs
+}
+
+func TestToLogRecord(t *testing.T) {
+	now := time.Now()
+	e := event.New()
+	e.SetID("abc")
+	e.SetType("com.example.test")
+	e.SetSource("/example")
+	e.SetSubject("sub")
+	e.SetTime(now)
+	e.SetExtension("aaa", "x")
+	e.SetExtension("bbb", 10)
+	require.NoError(t, e.SetData(event.ApplicationJSON, map[string]interface{}{
+		"a": 1,
+		"b": []interface{}{"two", true, 1.5},
+	}))
+
+	r := ToLogRecord(e)
+	require.True(t, now.Equal(r.Timestamp()))
+
+	attrs := recordAttributes(r)
+	require.Len(t, attrs, 8)
+	require.Equal(t, "1.0", attrs[observability.SpecversionAttr].AsString())
+	require.Equal(t, "abc", attrs[observability.IdAttr].AsString())
+	require.Equal(t, "com.example.test", attrs[observability.TypeAttr].AsString())
+	require.Equal(t, "/example", attrs[observability.SourceAttr].AsString())
+	require.Equal(t, "sub", attrs[observability.SubjectAttr].AsString())
+	require.Equal(t, event.ApplicationJSON, attrs[observability.DatacontenttypeAttr].AsString())
+	require.Equal(t, "x", attrs["cloudevents.aaa"].AsString())
+	require.Equal(t, int64(10), attrs["cloudevents.bbb"].AsInt64())
+
+	want := log.MapValue(
+		log.Int64("a", 1),
+		log.Slice("b", log.StringValue("two"), log.BoolValue(true), log.Float64Value(1.5)),
+	)
+	require.True(t, want.Equal(r.Body()), "unexpected body %s", r.Body())
+}
+
+func TestToLogRecord_Data(t *testing.T) {
+	testCases := map[string]struct {
+		contentType string
+		data        []byte
+		want        log.Value
+	}{
+		"no data": {
+			contentType: event.ApplicationJSON,
+			want:        log.Value{},
+		},
+		"text": {
+			contentType: event.TextPlain,
+			data:        []byte("hello"),
+			want:        log.StringValue("hello"),
+		},
+		"binary": {
+			contentType: "application/octet-stream",
+			data:        []byte{0, 1, 2},
+			want:        log.BytesValue([]byte{0, 1, 2}),
+		},
+		"invalid json": {
+			contentType: event.ApplicationJSON,
+			data:        []byte(`{"a":`),
+			want:        log.StringValue(`{"a":`),
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			e := event.New()
+			e.SetID("abc")
+			e.SetDataContentType(tc.contentType)
+			e.DataEncoded = tc.data
+
+			r := ToLogRecord(e)
+			require.True(t, tc.want.Equal(r.Body()), "unexpected body %s", r.Body())
+			require.True(t, r.Timestamp().IsZero())
+		})
+	}
+}
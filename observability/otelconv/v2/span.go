@@ -0,0 +1,95 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package otelconv
+
+import (
+	"context"
+	"sort"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/extensions"
+	"github.com/cloudevents/sdk-go/v2/observability"
+	"github.com/cloudevents/sdk-go/v2/types"
+)
+
+// ToSpan starts and ends with tracer a span representing the event, and returns its span context:
+//
+//   - the parent of the span is the span context carried by the distributed tracing extension
+//     (traceparent and tracestate) if valid, otherwise the span context of ctx, if any
+//   - the span is named after the event type, and it starts and ends at the event time, if set
+//   - the event attributes are mapped to the span attributes, as in ToLogRecord,
+//     except the distributed tracing extension
+//
+// opts are appended to the options used to start the span, e.g. trace.WithSpanKind.
+func ToSpan(ctx context.Context, e event.Event, tracer trace.Tracer, opts ...trace.SpanStartOption) trace.SpanContext {
+	carrier := propagation.MapCarrier{}
+	exts := e.Extensions()
+	for _, name := range []string{extensions.TraceParentExtension, extensions.TraceStateExtension} {
+		if v, ok := exts[name]; ok {
+			if s, err := types.Format(v); err == nil {
+				carrier.Set(name, s)
+			}
+		}
+	}
+	ctx = propagation.TraceContext{}.Extract(ctx, carrier)
+
+	startOpts := []trace.SpanStartOption{trace.WithAttributes(spanAttributes(e)...)}
+	var endOpts []trace.SpanEndOption
+	if t := e.Time(); !t.IsZero() {
+		startOpts = append(startOpts, trace.WithTimestamp(t))
+		endOpts = append(endOpts, trace.WithTimestamp(t))
+	}
+	_, span := tracer.Start(ctx, e.Type(), append(startOpts, opts...)...)
+	span.End(endOpts...)
+	return span.SpanContext()
+}
+
+func spanAttributes(e event.Event) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{
+		attribute.String(observability.SpecversionAttr, e.SpecVersion()),
+		attribute.String(observability.IdAttr, e.ID()),
+		attribute.String(observability.TypeAttr, e.Type()),
+		attribute.String(observability.SourceAttr, e.Source()),
+	}
+	if sub := e.Subject(); sub != "" {
+		attrs = append(attrs, attribute.String(observability.SubjectAttr, sub))
+	}
+	if dct := e.DataContentType(); dct != "" {
+		attrs = append(attrs, attribute.String(observability.DatacontenttypeAttr, dct))
+	}
+
+	exts := e.Extensions()
+	names := make([]string, 0, len(exts))
+	for name := range exts {
+		if name != extensions.TraceParentExtension && name != extensions.TraceStateExtension {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		attrs = append(attrs, spanExtensionAttribute(ExtensionAttrPrefix+name, exts[name]))
+	}
+	return attrs
+}
+
+func spanExtensionAttribute(key string, v interface{}) attribute.KeyValue {
+	switch v := v.(type) {
+	case bool:
+		return attribute.Bool(key, v)
+	case int32:
+		return attribute.Int64(key, int64(v))
+	}
+	// span attributes have no bytes type: binary values are base64 encoded, as for all the other types
+	s, err := types.Format(v)
+	if err != nil {
+		s = ""
+	}
+	return attribute.String(key, s)
+}
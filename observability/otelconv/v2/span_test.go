@@ -0,0 +1,110 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package otelconv
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/extensions"
+	"github.com/cloudevents/sdk-go/v2/observability"
+)
+
+// recordingTracer records the configuration of the started spans.
+type recordingTracer struct {
+	noop.Tracer
+
+	name   string
+	parent trace.SpanContext
+	start  trace.SpanConfig
+	end    trace.SpanConfig
+	ended  bool
+}
+
+type recordingSpan struct {
+	noop.Span
+	tracer *recordingTracer
+	sc     trace.SpanContext
+}
+
+func (s recordingSpan) SpanContext() trace.SpanContext { return s.sc }
+
+func (s recordingSpan) End(opts ...trace.SpanEndOption) {
+	s.tracer.end = trace.NewSpanEndConfig(opts...)
+	s.tracer.ended = true
+}
+
+func (t *recordingTracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	t.name = name
+	t.parent = trace.SpanContextFromContext(ctx)
+	t.start = trace.NewSpanStartConfig(opts...)
+	traceID := t.parent.TraceID()
+	if !traceID.IsValid() {
+		traceID = trace.TraceID{0x01}
+	}
+	span := recordingSpan{tracer: t, sc: trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: trace.SpanID{0x02}})}
+	return trace.ContextWithSpan(ctx, span), span
+}
+
+func TestToSpan(t *testing.T) {
+	now := time.Now()
+	e := event.New()
+	e.SetID("abc")
+	e.SetType("com.example.test")
+	e.SetSource("/example")
+	e.SetSubject("sub")
+	e.SetTime(now)
+	e.SetExtension("bbb", 10)
+	e.SetExtension(extensions.TraceParentExtension, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	e.SetExtension(extensions.TraceStateExtension, "vendor=value")
+
+	tracer := &recordingTracer{}
+	sc := ToSpan(context.Background(), e, tracer, trace.WithSpanKind(trace.SpanKindConsumer))
+
+	require.True(t, tracer.ended)
+	require.Equal(t, "com.example.test", tracer.name)
+	require.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", tracer.parent.TraceID().String())
+	require.Equal(t, "00f067aa0ba902b7", tracer.parent.SpanID().String())
+	require.True(t, tracer.parent.IsRemote())
+	require.Equal(t, "vendor=value", tracer.parent.TraceState().String())
+	require.Equal(t, tracer.parent.TraceID(), sc.TraceID())
+
+	require.Equal(t, trace.SpanKindConsumer, tracer.start.SpanKind())
+	require.True(t, tracer.start.Timestamp().Equal(now))
+	require.True(t, tracer.end.Timestamp().Equal(now))
+	require.ElementsMatch(t, []attribute.KeyValue{
+		attribute.String(observability.SpecversionAttr, "1.0"),
+		attribute.String(observability.IdAttr, "abc"),
+		attribute.String(observability.TypeAttr, "com.example.test"),
+		attribute.String(observability.SourceAttr, "/example"),
+		attribute.String(observability.SubjectAttr, "sub"),
+		attribute.Int64(ExtensionAttrPrefix+"bbb", 10),
+	}, tracer.start.Attributes())
+}
+
+func TestToSpan_ParentFromContext(t *testing.T) {
+	parent := trace.NewSpanContext(trace.SpanContextConfig{TraceID: trace.TraceID{0x0a}, SpanID: trace.SpanID{0x0b}})
+	ctx := trace.ContextWithSpanContext(context.Background(), parent)
+
+	e := event.New()
+	e.SetID("abc")
+	e.SetType("com.example.test")
+	e.SetSource("/example")
+	e.SetExtension(extensions.TraceParentExtension, "invalid")
+
+	tracer := &recordingTracer{}
+	ToSpan(ctx, e, tracer)
+
+	require.Equal(t, parent, tracer.parent)
+	require.True(t, tracer.start.Timestamp().IsZero())
+}
@@ -0,0 +1,46 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package amqp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+)
+
+// SettleAll settles all the messages of a batch, e.g. received with ReceiveN,
+// with the same disposition.
+// See SettleEach.
+func SettleAll(ctx context.Context, msgs []binding.Message, d Disposition) error {
+	return SettleEach(ctx, msgs, func(binding.Message) Disposition {
+		return d
+	})
+}
+
+// SettleEach settles each message of a batch, e.g. received with ReceiveN,
+// with the disposition returned by fn, allowing to accept some messages of the
+// batch and reject or release the others.
+// Messages for which fn returns DispositionNone are left unsettled, and must be
+// finished by the caller; the settled messages must not be finished again.
+// SettleEach attempts to settle all the messages, and returns the first error
+// encountered, if any.
+func SettleEach(ctx context.Context, msgs []binding.Message, fn func(binding.Message) Disposition) error {
+	var firstErr error
+	for i, m := range msgs {
+		msg, ok := m.(*Message)
+		if !ok {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("cannot settle message %d of type %T, expected *amqp.Message", i, m)
+			}
+			continue
+		}
+		if err := msg.settle(ctx, fn(m), "message rejected by the batch"); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("failed to settle message %d: %w", i, err)
+		}
+	}
+	return firstErr
+}
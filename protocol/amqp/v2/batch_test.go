@@ -0,0 +1,107 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package amqp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/go-amqp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+)
+
+func TestReceiver_ReceiveN(t *testing.T) {
+	m1 := amqp.NewMessage([]byte("1"))
+	m2 := amqp.NewMessage([]byte("2"))
+	m3 := amqp.NewMessage([]byte("3"))
+	link := &fakeReceiverLink{messages: []*amqp.Message{m1, m2, m3}}
+	r := newReceiver(link, amqp.ReceiveOptions{})
+
+	got, err := r.ReceiveN(context.Background(), 2)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Same(t, m1, got[0].(*Message).AMQP)
+	require.Same(t, m2, got[1].(*Message).AMQP)
+
+	// Only the remaining prefetched message is returned, without waiting for more
+	got, err = r.ReceiveN(context.Background(), 2)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Same(t, m3, got[0].(*Message).AMQP)
+
+	_, err = r.ReceiveN(context.Background(), 0)
+	require.Error(t, err)
+}
+
+func TestReceiver_ReceiveNWithDispositionFunc(t *testing.T) {
+	m1 := amqp.NewMessage([]byte("handle"))
+	m2 := amqp.NewMessage([]byte("accept"))
+	m3 := amqp.NewMessage([]byte("handle"))
+	link := &fakeReceiverLink{messages: []*amqp.Message{m1, m2, m3}}
+	r := newReceiver(link, amqp.ReceiveOptions{}, WithDispositionFunc(func(ctx context.Context, m binding.Message) Disposition {
+		if string(m.(*Message).AMQP.GetData()) == "accept" {
+			return DispositionAccept
+		}
+		return DispositionNone
+	}))
+
+	got, err := r.ReceiveN(context.Background(), 3)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Same(t, m1, got[0].(*Message).AMQP)
+	require.Same(t, m3, got[1].(*Message).AMQP)
+	require.Equal(t, []*amqp.Message{m2}, link.accepted)
+}
+
+func TestSettleAll(t *testing.T) {
+	m1 := amqp.NewMessage([]byte("1"))
+	m2 := amqp.NewMessage([]byte("2"))
+	link := &fakeReceiverLink{messages: []*amqp.Message{m1, m2}}
+	r := newReceiver(link, amqp.ReceiveOptions{})
+
+	got, err := r.ReceiveN(context.Background(), 2)
+	require.NoError(t, err)
+	require.NoError(t, SettleAll(context.Background(), got, DispositionAccept))
+	require.Equal(t, []*amqp.Message{m1, m2}, link.accepted)
+}
+
+func TestSettleEach(t *testing.T) {
+	accept := amqp.NewMessage([]byte("accept"))
+	reject := amqp.NewMessage([]byte("reject"))
+	release := amqp.NewMessage([]byte("release"))
+	none := amqp.NewMessage([]byte("none"))
+	link := &fakeReceiverLink{messages: []*amqp.Message{accept, reject, release, none}}
+	r := newReceiver(link, amqp.ReceiveOptions{})
+
+	got, err := r.ReceiveN(context.Background(), 4)
+	require.NoError(t, err)
+	require.NoError(t, SettleEach(context.Background(), got, func(m binding.Message) Disposition {
+		switch string(m.(*Message).AMQP.GetData()) {
+		case "accept":
+			return DispositionAccept
+		case "reject":
+			return DispositionReject
+		case "release":
+			return DispositionRelease
+		}
+		return DispositionNone
+	}))
+	require.Equal(t, []*amqp.Message{accept}, link.accepted)
+	require.Equal(t, []*amqp.Message{reject}, link.rejected)
+	require.Equal(t, []*amqp.Message{release}, link.released)
+}
+
+func TestSettleEach_NotAnAMQPMessage(t *testing.T) {
+	m := amqp.NewMessage([]byte("1"))
+	link := &fakeReceiverLink{}
+	msgs := []binding.Message{binding.WithFinish(newMessage(m, link), nil), newMessage(m, link)}
+
+	require.Error(t, SettleAll(context.Background(), msgs, DispositionAccept))
+	// The other messages are settled anyway
+	require.Equal(t, []*amqp.Message{m}, link.accepted)
+}
@@ -0,0 +1,234 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package amqp
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-amqp"
+)
+
+// Application properties of the AMQP messages holding a chunk of a larger message.
+// They don't use the cloudEvents: prefix, so they are not mistaken for event attributes.
+const (
+	chunkIDProperty    = "cloudEventsChunk:id"
+	chunkIndexProperty = "cloudEventsChunk:index"
+	chunkCountProperty = "cloudEventsChunk:count"
+)
+
+// chunkMessage splits the data of amqpMessage across as many AMQP messages as
+// needed to have at most maxSize bytes of data each.
+// Every chunk carries the metadata of amqpMessage, plus the chunk id, index and count.
+// If the data of amqpMessage fits in maxSize bytes, amqpMessage is returned as is.
+func chunkMessage(amqpMessage *amqp.Message, maxSize int) ([]*amqp.Message, error) {
+	data := bytes.Join(amqpMessage.Data, nil)
+	if len(data) <= maxSize {
+		return []*amqp.Message{amqpMessage}, nil
+	}
+
+	// When the message-id is set, e.g. WithPublisherDedup, the chunk id is
+	// derived from it, so that the chunks of a retried message are the same.
+	var id string
+	if amqpMessage.Properties != nil && amqpMessage.Properties.MessageID != nil {
+		id = fmt.Sprintf("%v", amqpMessage.Properties.MessageID)
+	} else {
+		var err error
+		if id, err = newChunkID(); err != nil {
+			return nil, err
+		}
+	}
+	count := (len(data) + maxSize - 1) / maxSize
+	chunks := make([]*amqp.Message, 0, count)
+	for i := 0; i < count; i++ {
+		end := (i + 1) * maxSize
+		if end > len(data) {
+			end = len(data)
+		}
+
+		chunk := *amqpMessage
+		chunk.Data = [][]byte{data[i*maxSize : end]}
+		chunk.ApplicationProperties = make(map[string]interface{}, len(amqpMessage.ApplicationProperties)+3)
+		for k, v := range amqpMessage.ApplicationProperties {
+			chunk.ApplicationProperties[k] = v
+		}
+		chunk.ApplicationProperties[chunkIDProperty] = id
+		chunk.ApplicationProperties[chunkIndexProperty] = int32(i)
+		chunk.ApplicationProperties[chunkCountProperty] = int32(count)
+		if chunk.Properties != nil && chunk.Properties.MessageID != nil {
+			// Every chunk needs its own message-id, not to be discarded by the broker dedup
+			properties := *chunk.Properties
+			properties.MessageID = fmt.Sprintf("%v/%d", amqpMessage.Properties.MessageID, i)
+			chunk.Properties = &properties
+		}
+		chunks = append(chunks, &chunk)
+	}
+	return chunks, nil
+}
+
+func newChunkID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("cannot generate the chunk id: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// chunkInfo returns the chunk id, index and count of m, ok is false if m is not a chunk.
+func chunkInfo(m *amqp.Message) (id string, index, count int, ok bool, err error) {
+	v, isChunk := m.ApplicationProperties[chunkIDProperty]
+	if !isChunk {
+		return "", 0, 0, false, nil
+	}
+	id, isString := v.(string)
+	if !isString || id == "" {
+		return "", 0, 0, true, fmt.Errorf("invalid chunk id %v", v)
+	}
+	index, err = chunkNumber(m, chunkIndexProperty)
+	if err != nil {
+		return "", 0, 0, true, err
+	}
+	count, err = chunkNumber(m, chunkCountProperty)
+	if err != nil {
+		return "", 0, 0, true, err
+	}
+	if count <= 0 || index < 0 || index >= count {
+		return "", 0, 0, true, fmt.Errorf("invalid chunk index %d of %d", index, count)
+	}
+	return id, index, count, true, nil
+}
+
+func chunkNumber(m *amqp.Message, property string) (int, error) {
+	switch v := m.ApplicationProperties[property].(type) {
+	case int32:
+		return int(v), nil
+	case int64:
+		return int(v), nil
+	case uint32:
+		return int(v), nil
+	default:
+		return 0, fmt.Errorf("invalid chunk property %s: %v", property, v)
+	}
+}
+
+// chunkGroup holds the chunks received so far for a chunk id.
+type chunkGroup struct {
+	chunks   []*amqp.Message
+	received int
+	deadline time.Time
+}
+
+// reassembler collects the chunks of the received messages until all the
+// chunks of a message are received.
+type reassembler struct {
+	timeout time.Duration
+	now     func() time.Time
+
+	mu     sync.Mutex
+	groups map[string]*chunkGroup
+}
+
+func newReassembler(timeout time.Duration) *reassembler {
+	return &reassembler{
+		timeout: timeout,
+		now:     time.Now,
+		groups:  make(map[string]*chunkGroup),
+	}
+}
+
+// add adds a chunk to its group. If the group is complete, it returns the
+// reassembled message together with its chunks, otherwise it returns nil.
+// It also returns the chunks of the groups which were not completed before
+// the timeout, which must be rejected by the caller.
+func (r *reassembler) add(m *amqp.Message, id string, index, count int) (assembled *amqp.Message, chunks []*amqp.Message, expired []*amqp.Message, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	for gid, g := range r.groups {
+		if now.After(g.deadline) {
+			for _, c := range g.chunks {
+				if c != nil {
+					expired = append(expired, c)
+				}
+			}
+			delete(r.groups, gid)
+		}
+	}
+
+	g, ok := r.groups[id]
+	if !ok {
+		g = &chunkGroup{chunks: make([]*amqp.Message, count), deadline: now.Add(r.timeout)}
+		r.groups[id] = g
+	}
+	if len(g.chunks) != count {
+		return nil, nil, expired, fmt.Errorf("chunk %d of message %s has count %d, expected %d", index, id, count, len(g.chunks))
+	}
+	if g.chunks[index] != nil {
+		return nil, nil, expired, fmt.Errorf("duplicate chunk %d of message %s", index, id)
+	}
+	g.chunks[index] = m
+	g.received++
+	if g.received < count {
+		return nil, nil, expired, nil
+	}
+
+	delete(r.groups, id)
+	data := make([][]byte, 0, count)
+	for _, c := range g.chunks {
+		data = append(data, bytes.Join(c.Data, nil))
+	}
+	first := *g.chunks[0]
+	first.Data = [][]byte{bytes.Join(data, nil)}
+	first.ApplicationProperties = make(map[string]interface{}, len(g.chunks[0].ApplicationProperties))
+	for k, v := range g.chunks[0].ApplicationProperties {
+		switch k {
+		case chunkIDProperty, chunkIndexProperty, chunkCountProperty:
+		default:
+			first.ApplicationProperties[k] = v
+		}
+	}
+	if first.Properties != nil && first.Properties.MessageID != nil {
+		// Restore the message-id of the chunked message, see chunkMessage
+		properties := *first.Properties
+		properties.MessageID = id
+		first.Properties = &properties
+	}
+	return &first, g.chunks, expired, nil
+}
+
+// chunksSettler settles all the chunks of a reassembled message together.
+type chunksSettler struct {
+	link   settler
+	chunks []*amqp.Message
+}
+
+func (s *chunksSettler) AcceptMessage(ctx context.Context, _ *amqp.Message) error {
+	return s.each(func(c *amqp.Message) error { return s.link.AcceptMessage(ctx, c) })
+}
+
+func (s *chunksSettler) RejectMessage(ctx context.Context, _ *amqp.Message, e *amqp.Error) error {
+	return s.each(func(c *amqp.Message) error { return s.link.RejectMessage(ctx, c, e) })
+}
+
+func (s *chunksSettler) ReleaseMessage(ctx context.Context, _ *amqp.Message) error {
+	return s.each(func(c *amqp.Message) error { return s.link.ReleaseMessage(ctx, c) })
+}
+
+func (s *chunksSettler) each(fn func(*amqp.Message) error) error {
+	var firstErr error
+	for _, c := range s.chunks {
+		if err := fn(c); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
@@ -0,0 +1,131 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package amqp
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-amqp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/event"
+)
+
+type fakeSenderLink struct {
+	sent []*amqp.Message
+}
+
+func (f *fakeSenderLink) Send(ctx context.Context, msg *amqp.Message, opts *amqp.SendOptions) error {
+	f.sent = append(f.sent, msg)
+	return nil
+}
+
+func (f *fakeSenderLink) Close(ctx context.Context) error {
+	return nil
+}
+
+func newChunkTestEvent(t *testing.T, data string) event.Event {
+	e := event.New()
+	e.SetID("id")
+	e.SetType("unit.test")
+	e.SetSource("/unit/test")
+	e.SetExtension("exstring", "value")
+	require.NoError(t, e.SetData(event.TextPlain, data))
+	return e
+}
+
+func TestChunking_RoundTrip(t *testing.T) {
+	for _, encoding := range []string{"structured", "binary"} {
+		t.Run(encoding, func(t *testing.T) {
+			ctx := binding.WithForceBinary(context.Background())
+			if encoding == "structured" {
+				ctx = binding.WithForceStructured(context.Background())
+			}
+			want := newChunkTestEvent(t, strings.Repeat("0123456789", 10))
+
+			link := &fakeSenderLink{}
+			s := &sender{amqp: link}
+			WithMaxMessageSize(32)(s)
+			require.NoError(t, s.Send(ctx, binding.ToMessage(&want)))
+			require.Greater(t, len(link.sent), 1)
+			for _, m := range link.sent {
+				require.LessOrEqual(t, len(m.GetData()), 32)
+			}
+
+			// Deliver the chunks out of order
+			received := append([]*amqp.Message{}, link.sent[1:]...)
+			received = append(received, link.sent[0])
+			rcvLink := &fakeReceiverLink{messages: received}
+			r := newReceiver(rcvLink, amqp.ReceiveOptions{}, WithChunkReassembly(time.Minute))
+
+			msg, err := r.Receive(context.Background())
+			require.NoError(t, err)
+			got, err := binding.ToEvent(context.Background(), msg)
+			require.NoError(t, err)
+			require.Equal(t, want.ID(), got.ID())
+			require.Equal(t, want.Extensions(), got.Extensions())
+			require.Equal(t, string(want.Data()), string(got.Data()))
+
+			require.NoError(t, msg.Finish(nil))
+			require.ElementsMatch(t, link.sent, rcvLink.accepted)
+		})
+	}
+}
+
+func TestChunking_SmallMessageNotChunked(t *testing.T) {
+	e := newChunkTestEvent(t, "small")
+
+	link := &fakeSenderLink{}
+	s := &sender{amqp: link}
+	WithMaxMessageSize(1024)(s)
+	require.NoError(t, s.Send(context.Background(), binding.ToMessage(&e)))
+	require.Len(t, link.sent, 1)
+	_, ok := link.sent[0].ApplicationProperties[chunkIDProperty]
+	require.False(t, ok)
+}
+
+func TestChunking_Timeout(t *testing.T) {
+	e := newChunkTestEvent(t, strings.Repeat("0123456789", 10))
+	link := &fakeSenderLink{}
+	s := &sender{amqp: link}
+	WithMaxMessageSize(32)(s)
+	require.NoError(t, s.Send(binding.WithForceStructured(context.Background()), binding.ToMessage(&e)))
+
+	rcvLink := &fakeReceiverLink{}
+	r := newReceiver(rcvLink, amqp.ReceiveOptions{}, WithChunkReassembly(time.Second))
+	now := time.Now()
+	r.reassembler.now = func() time.Time { return now }
+
+	// The first chunk is retained, then the timeout elapses
+	msg, err := r.process(context.Background(), link.sent[0])
+	require.NoError(t, err)
+	require.Nil(t, msg)
+
+	now = now.Add(2 * time.Second)
+	_, err = r.process(context.Background(), link.sent[1])
+	require.NoError(t, err)
+	require.Equal(t, []*amqp.Message{link.sent[0]}, rcvLink.rejected)
+}
+
+func TestChunking_InvalidChunkRejected(t *testing.T) {
+	m := amqp.NewMessage([]byte("chunk"))
+	m.ApplicationProperties = map[string]interface{}{
+		chunkIDProperty:    "id",
+		chunkIndexProperty: int32(3),
+		chunkCountProperty: int32(2),
+	}
+	rcvLink := &fakeReceiverLink{}
+	r := newReceiver(rcvLink, amqp.ReceiveOptions{}, WithChunkReassembly(time.Minute))
+
+	msg, err := r.process(context.Background(), m)
+	require.NoError(t, err)
+	require.Nil(t, msg)
+	require.Equal(t, []*amqp.Message{m}, rcvLink.rejected)
+}
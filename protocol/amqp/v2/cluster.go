@@ -0,0 +1,145 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package amqp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-amqp"
+)
+
+// FailoverStrategy selects the order in which the endpoints of a Cluster are dialed.
+type FailoverStrategy int
+
+const (
+	// FailoverPriority dials the endpoints in the given order, so the first
+	// healthy endpoint of the list is always preferred.
+	FailoverPriority FailoverStrategy = iota
+	// FailoverRoundRobin dials the endpoints starting from the one following
+	// the active endpoint, spreading the reconnections across the endpoints.
+	FailoverRoundRobin
+)
+
+// ClusterOption is the type of DialCluster options.
+type ClusterOption func(*Cluster)
+
+// WithFailoverStrategy sets the order in which the endpoints are dialed.
+// Defaults to FailoverPriority.
+func WithFailoverStrategy(strategy FailoverStrategy) ClusterOption {
+	return func(c *Cluster) {
+		c.strategy = strategy
+	}
+}
+
+// WithClusterConnOptions sets the options used to dial every endpoint.
+func WithClusterConnOptions(opts *amqp.ConnOptions) ClusterOption {
+	return func(c *Cluster) {
+		c.connOptions = opts
+	}
+}
+
+// Cluster is a connection to one of multiple broker endpoints, e.g. the nodes
+// of a highly available broker. When the connection fails, Redial connects to
+// the next healthy endpoint.
+type Cluster struct {
+	addrs       []string
+	strategy    FailoverStrategy
+	connOptions *amqp.ConnOptions
+	dial        func(ctx context.Context, addr string, opts *amqp.ConnOptions) (*amqp.Conn, error)
+
+	mu        sync.Mutex
+	conn      *amqp.Conn
+	connected bool
+	active    int
+}
+
+// DialCluster connects to the first healthy endpoint of addrs, in the order of
+// the FailoverStrategy. The connection is returned by Cluster.Conn, e.g. to
+// create the protocol with NewProtocolFromClient.
+func DialCluster(ctx context.Context, addrs []string, opts ...ClusterOption) (*Cluster, error) {
+	if len(addrs) == 0 {
+		return nil, errors.New("no endpoints to dial")
+	}
+	c := &Cluster{
+		addrs:  addrs,
+		dial:   amqp.Dial,
+		active: -1,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if err := c.Redial(ctx); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Conn returns the connection to the active endpoint.
+func (c *Cluster) Conn() *amqp.Conn {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.conn
+}
+
+// Active returns the address of the active endpoint, or "" if not connected.
+func (c *Cluster) Active() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.connected {
+		return ""
+	}
+	return c.addrs[c.active]
+}
+
+// Redial closes the current connection, if any, and connects to the next
+// healthy endpoint, in the order of the FailoverStrategy. The sessions and links
+// of the previous connection must be recreated on the new one.
+func (c *Cluster) Redial(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.conn != nil {
+		_ = c.conn.Close()
+	}
+	c.conn, c.connected = nil, false
+
+	start := 0
+	if c.strategy == FailoverRoundRobin {
+		start = c.active + 1
+	}
+	var errs []string
+	for i := range c.addrs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		index := (start + i) % len(c.addrs)
+		conn, err := c.dial(ctx, c.addrs[index], c.connOptions)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", c.addrs[index], err))
+			continue
+		}
+		c.conn, c.connected = conn, true
+		c.active = index
+		return nil
+	}
+	return fmt.Errorf("cannot connect to any endpoint: %s", strings.Join(errs, "; "))
+}
+
+// Close closes the connection to the active endpoint.
+func (c *Cluster) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.connected = false
+	if c.conn == nil {
+		return nil
+	}
+	err := c.conn.Close()
+	c.conn = nil
+	return err
+}
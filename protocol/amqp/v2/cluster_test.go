@@ -0,0 +1,84 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package amqp
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Azure/go-amqp"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDialer records the dialed endpoints, failing for the unhealthy ones.
+type fakeDialer struct {
+	unhealthy map[string]bool
+	dialed    []string
+}
+
+func (d *fakeDialer) dial(_ context.Context, addr string, _ *amqp.ConnOptions) (*amqp.Conn, error) {
+	d.dialed = append(d.dialed, addr)
+	if d.unhealthy[addr] {
+		return nil, errors.New("connection refused")
+	}
+	return nil, nil
+}
+
+func newTestCluster(t *testing.T, d *fakeDialer, strategy FailoverStrategy) *Cluster {
+	c := &Cluster{
+		addrs:    []string{"amqp://a", "amqp://b", "amqp://c"},
+		strategy: strategy,
+		dial:     d.dial,
+		active:   -1,
+	}
+	require.NoError(t, c.Redial(context.Background()))
+	return c
+}
+
+func TestCluster_Priority(t *testing.T) {
+	d := &fakeDialer{unhealthy: map[string]bool{"amqp://a": true}}
+	c := newTestCluster(t, d, FailoverPriority)
+	require.Equal(t, "amqp://b", c.Active())
+	require.Equal(t, []string{"amqp://a", "amqp://b"}, d.dialed)
+
+	// the first endpoint is preferred again once healthy
+	d.unhealthy = nil
+	d.dialed = nil
+	require.NoError(t, c.Redial(context.Background()))
+	require.Equal(t, "amqp://a", c.Active())
+	require.Equal(t, []string{"amqp://a"}, d.dialed)
+}
+
+func TestCluster_RoundRobin(t *testing.T) {
+	d := &fakeDialer{}
+	c := newTestCluster(t, d, FailoverRoundRobin)
+	require.Equal(t, "amqp://a", c.Active())
+
+	require.NoError(t, c.Redial(context.Background()))
+	require.Equal(t, "amqp://b", c.Active())
+
+	d.unhealthy = map[string]bool{"amqp://c": true}
+	require.NoError(t, c.Redial(context.Background()))
+	require.Equal(t, "amqp://a", c.Active())
+	require.Equal(t, []string{"amqp://a", "amqp://b", "amqp://c", "amqp://a"}, d.dialed)
+}
+
+func TestCluster_AllUnhealthy(t *testing.T) {
+	d := &fakeDialer{}
+	c := newTestCluster(t, d, FailoverPriority)
+
+	d.unhealthy = map[string]bool{"amqp://a": true, "amqp://b": true, "amqp://c": true}
+	err := c.Redial(context.Background())
+	require.EqualError(t, err, "cannot connect to any endpoint: amqp://a: connection refused; amqp://b: connection refused; amqp://c: connection refused")
+	require.Equal(t, "", c.Active())
+	require.Nil(t, c.Conn())
+}
+
+func TestDialCluster_NoEndpoints(t *testing.T) {
+	_, err := DialCluster(context.Background(), nil)
+	require.EqualError(t, err, "no endpoints to dial")
+}
@@ -8,14 +8,18 @@ package amqp
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/Azure/go-amqp"
 
 	"github.com/cloudevents/sdk-go/v2/binding"
 	"github.com/cloudevents/sdk-go/v2/binding/format"
 	"github.com/cloudevents/sdk-go/v2/binding/spec"
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/extensions"
 )
 
 const prefix = "cloudEvents:" // Name prefix for AMQP properties that hold CE attributes.
@@ -26,6 +30,19 @@ var (
 	specs     = spec.WithPrefix(prefix)
 )
 
+// settler is the subset of *amqp.Receiver methods used to settle a message.
+type settler interface {
+	AcceptMessage(ctx context.Context, msg *amqp.Message) error
+	RejectMessage(ctx context.Context, msg *amqp.Message, e *amqp.Error) error
+	ReleaseMessage(ctx context.Context, msg *amqp.Message) error
+}
+
+// linkInfo is the subset of *amqp.Receiver methods describing the link.
+type linkInfo interface {
+	Address() string
+	LinkName() string
+}
+
 // Message implements binding.Message by wrapping an *amqp.Message.
 // This message *can* be read several times safely
 type Message struct {
@@ -34,11 +51,24 @@ type Message struct {
 
 	version spec.Version
 	format  format.Format
+	settler settler
+
+	// extensions.ExpiryTimeExtension is set WithExpiryTimeExtension
+	expiryTimeFromAMQP bool
+	// propertyPrefix is the custom prefix of the properties holding the
+	// attributes, set WithReceiverPropertyPrefix
+	propertyPrefix string
 }
 
 // NewMessage wrap an *amqp.Message in a binding.Message.
 // The returned message *can* be read several times safely
 func NewMessage(message *amqp.Message, receiver *amqp.Receiver) *Message {
+	m := newMessage(message, nil)
+	m.AMQPrcv = receiver
+	return m
+}
+
+func newMessage(message *amqp.Message, s settler) *Message {
 	var vn spec.Version
 	var fmt format.Format
 	if message.Properties != nil && message.Properties.ContentType != nil &&
@@ -47,7 +77,7 @@ func NewMessage(message *amqp.Message, receiver *amqp.Receiver) *Message {
 	} else if sv := getSpecVersion(message); sv != nil {
 		vn = sv
 	}
-	return &Message{AMQP: message, AMQPrcv: receiver, format: fmt, version: vn}
+	return &Message{AMQP: message, format: fmt, version: vn, settler: s}
 }
 
 var (
@@ -77,6 +107,12 @@ func (m *Message) ReadEncoding() binding.Encoding {
 func (m *Message) ReadStructured(ctx context.Context, encoder binding.StructuredWriter) error {
 	if m.format != nil {
 		data := m.getAmqpData()
+		if expiry, ok := m.expiryTime(); ok {
+			var err error
+			if data, err = m.withStructuredExpiryTime(data, expiry); err != nil {
+				return err
+			}
+		}
 		return encoder.SetStructuredEvent(ctx, m.format, bytes.NewReader(data))
 	}
 	return binding.ErrNotStructured
@@ -96,19 +132,28 @@ func (m *Message) ReadBinary(ctx context.Context, encoder binding.BinaryWriter)
 	}
 
 	for k, v := range m.AMQP.ApplicationProperties {
-		if strings.HasPrefix(k, prefix) {
-			attr := m.version.Attribute(k)
-			if attr != nil {
-				err = encoder.SetAttribute(attr, v)
-			} else {
-				err = encoder.SetExtension(strings.ToLower(strings.TrimPrefix(k, prefix)), v)
-			}
+		name, ok := m.attributeName(k)
+		if !ok {
+			continue
+		}
+		if attr := m.version.Attribute(prefix + name); attr != nil {
+			err = encoder.SetAttribute(attr, v)
+		} else {
+			err = encoder.SetExtension(strings.ToLower(name), v)
 		}
 		if err != nil {
 			return err
 		}
 	}
 
+	if expiry, ok := m.expiryTime(); ok {
+		if _, set := m.property(extensions.ExpiryTimeExtension); !set {
+			if err = encoder.SetExtension(extensions.ExpiryTimeExtension, expiry); err != nil {
+				return err
+			}
+		}
+	}
+
 	data := m.getAmqpData()
 	if len(data) != 0 { // Some data
 		err = encoder.SetData(bytes.NewBuffer(data))
@@ -122,23 +167,178 @@ func (m *Message) ReadBinary(ctx context.Context, encoder binding.BinaryWriter)
 func (m *Message) GetAttribute(k spec.Kind) (spec.Attribute, interface{}) {
 	attr := m.version.AttributeFromKind(k)
 	if attr != nil {
-		return attr, m.AMQP.ApplicationProperties[attr.PrefixedName()]
+		v, _ := m.property(attr.Name())
+		return attr, v
 	}
 	return nil, nil
 }
 
 func (m *Message) GetExtension(name string) interface{} {
-	return m.AMQP.ApplicationProperties[prefix+name]
+	v, ok := m.property(name)
+	if !ok && name == extensions.ExpiryTimeExtension {
+		if expiry, ok := m.expiryTime(); ok {
+			return expiry
+		}
+	}
+	return v
+}
+
+// eventType returns the type attribute of m without decoding the event: from
+// the application properties in binary mode, or the type member of the JSON
+// format in structured mode, the other formats being decoded. ok is false if
+// the type can't be read, e.g. when m is not a CloudEvent.
+func (m *Message) eventType() (typ string, ok bool) {
+	switch {
+	case m.version != nil:
+		_, v := m.GetAttribute(spec.Type)
+		typ, ok = v.(string)
+		return typ, ok
+	case m.format == format.JSON:
+		var e struct {
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal(m.getAmqpData(), &e); err != nil {
+			return "", false
+		}
+		return e.Type, true
+	case m.format != nil:
+		var e event.Event
+		if err := m.format.Unmarshal(m.getAmqpData(), &e); err != nil {
+			return "", false
+		}
+		return e.Type(), true
+	}
+	return "", false
+}
+
+// usePropertyPrefix makes m read the attributes and the extensions from the
+// properties with the custom prefix p, or the default one.
+func (m *Message) usePropertyPrefix(p string) {
+	if p == "" || p == prefix {
+		return
+	}
+	m.propertyPrefix = p
+	if m.format == nil && m.version == nil {
+		if sv, ok := m.property("specversion"); ok {
+			if svs, ok := sv.(string); ok {
+				m.version = specs.Version(svs)
+			}
+		}
+	}
+}
+
+// property returns the value of the property holding the attribute or the
+// extension name, looking for the custom prefix first, then the default one.
+func (m *Message) property(name string) (interface{}, bool) {
+	if m.propertyPrefix != "" {
+		if v, ok := m.AMQP.ApplicationProperties[m.propertyPrefix+name]; ok {
+			return v, true
+		}
+	}
+	v, ok := m.AMQP.ApplicationProperties[prefix+name]
+	return v, ok
+}
+
+// attributeName returns the name of the attribute or the extension held by
+// the property k, ok is false if k doesn't hold one. A property with the
+// default prefix is ignored if the same one with the custom prefix exists.
+func (m *Message) attributeName(k string) (name string, ok bool) {
+	if m.propertyPrefix != "" && strings.HasPrefix(k, m.propertyPrefix) {
+		return strings.TrimPrefix(k, m.propertyPrefix), true
+	}
+	if !strings.HasPrefix(k, prefix) {
+		return "", false
+	}
+	name = strings.TrimPrefix(k, prefix)
+	if m.propertyPrefix != "" {
+		if _, dup := m.AMQP.ApplicationProperties[m.propertyPrefix+name]; dup {
+			return "", false
+		}
+	}
+	return name, true
+}
+
+// expiryTime returns the AMQP absolute-expiry-time of the message, if it must
+// be surfaced as the expirytime extension, see WithExpiryTimeExtension.
+func (m *Message) expiryTime() (time.Time, bool) {
+	if !m.expiryTimeFromAMQP || m.AMQP.Properties == nil || m.AMQP.Properties.AbsoluteExpiryTime == nil {
+		return time.Time{}, false
+	}
+	return *m.AMQP.Properties.AbsoluteExpiryTime, true
+}
+
+// withStructuredExpiryTime adds the expirytime extension to the structured
+// event in data, unless the event already has it.
+func (m *Message) withStructuredExpiryTime(data []byte, expiry time.Time) ([]byte, error) {
+	var e event.Event
+	if err := m.format.Unmarshal(data, &e); err != nil {
+		return nil, err
+	}
+	if _, ok := e.Extensions()[extensions.ExpiryTimeExtension]; ok {
+		return data, nil
+	}
+	e.SetExtension(extensions.ExpiryTimeExtension, expiry)
+	return m.format.Marshal(&e)
 }
 
 func (m *Message) Finish(err error) error {
 	if err != nil {
-		return m.AMQPrcv.RejectMessage(context.Background(), m.AMQP, &amqp.Error{
+		return m.receiver().RejectMessage(context.Background(), m.AMQP, &amqp.Error{
 			Condition:   condition,
 			Description: err.Error(),
 		})
 	}
-	return m.AMQPrcv.AcceptMessage(context.Background(), m.AMQP)
+	return m.receiver().AcceptMessage(context.Background(), m.AMQP)
+}
+
+// settle settles the message according to the provided disposition.
+func (m *Message) settle(ctx context.Context, d Disposition, rejectDescription string) error {
+	switch d {
+	case DispositionAccept:
+		return m.receiver().AcceptMessage(ctx, m.AMQP)
+	case DispositionReject:
+		return m.receiver().RejectMessage(ctx, m.AMQP, &amqp.Error{
+			Condition:   condition,
+			Description: rejectDescription,
+		})
+	case DispositionRelease:
+		return m.receiver().ReleaseMessage(ctx, m.AMQP)
+	}
+	return nil
+}
+
+// SourceAddress returns the source address of the link the message was received from,
+// e.g. the queue name, or an empty string if unknown.
+func (m *Message) SourceAddress() string {
+	if l := m.link(); l != nil {
+		return l.Address()
+	}
+	return ""
+}
+
+// LinkName returns the name of the link the message was received from, or an empty string if unknown.
+func (m *Message) LinkName() string {
+	if l := m.link(); l != nil {
+		return l.LinkName()
+	}
+	return ""
+}
+
+func (m *Message) link() linkInfo {
+	if m.AMQPrcv != nil {
+		return m.AMQPrcv
+	}
+	if l, ok := m.settler.(linkInfo); ok {
+		return l
+	}
+	return nil
+}
+
+func (m *Message) receiver() settler {
+	if m.settler != nil {
+		return m.settler
+	}
+	return m.AMQPrcv
 }
 
 // fixes: github.com/cloudevents/spec/issues/1275
@@ -117,3 +117,42 @@ func TestMessage_getAmqpData(t *testing.T) {
 		})
 	}
 }
+
+type fakeLink struct {
+	fakeReceiverLink
+	address string
+	name    string
+}
+
+func (f *fakeLink) Address() string {
+	return f.address
+}
+
+func (f *fakeLink) LinkName() string {
+	return f.name
+}
+
+func TestMessage_LinkInfo(t *testing.T) {
+	t.Run("unknown link", func(t *testing.T) {
+		m := NewMessage(amqp.NewMessage(nil), nil)
+		require.Equal(t, "", m.SourceAddress())
+		require.Equal(t, "", m.LinkName())
+	})
+
+	t.Run("amqp receiver without source", func(t *testing.T) {
+		m := NewMessage(amqp.NewMessage(nil), &amqp.Receiver{})
+		require.Equal(t, "", m.SourceAddress())
+		require.Equal(t, "", m.LinkName())
+	})
+
+	t.Run("receiver link", func(t *testing.T) {
+		link := &fakeLink{address: "my-queue", name: "my-link"}
+		link.messages = []*amqp.Message{amqp.NewMessage([]byte("hello"))}
+		r := newReceiver(link, amqp.ReceiveOptions{})
+
+		got, err := r.Receive(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "my-queue", got.(*Message).SourceAddress())
+		require.Equal(t, "my-link", got.(*Message).LinkName())
+	})
+}
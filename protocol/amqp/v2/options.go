@@ -0,0 +1,180 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package amqp
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+)
+
+// SendOption is the type of amqp sender options
+type SendOption func(*sender)
+
+// WithTTLFromContext makes the sender derive the AMQP message time-to-live
+// (header.ttl and properties.absolute-expiry-time) from the deadline of the
+// context passed to Send, if any. This allows the broker to discard messages
+// which were not delivered before the deadline expired.
+func WithTTLFromContext() SendOption {
+	return func(s *sender) {
+		s.ttlFromContext = true
+	}
+}
+
+// WithMaxMessageSize makes the sender split the data of the messages larger
+// than maxSize bytes across multiple AMQP messages, each holding at most
+// maxSize bytes of data and a copy of the message properties, for brokers
+// limiting the size of the messages. The receiver must be configured
+// WithChunkReassembly to reassemble them into a single message.
+// Messages forwarded as is, i.e. received from another amqp receiver, are not split.
+func WithMaxMessageSize(maxSize int) SendOption {
+	return func(s *sender) {
+		s.maxMessageSize = maxSize
+	}
+}
+
+// WithPublisherDedup makes the sender set the AMQP message-id to the event id,
+// unless the message-id is already set. As the message-id is stable across the
+// retries of the same event, brokers supporting duplicate detection based on the
+// message-id can discard the duplicates caused by retried sends.
+// Duplicate detection is a broker feature, which usually needs to be enabled on
+// the queue or topic, with a detection time window: e.g. Azure Service Bus
+// supports it, while RabbitMQ and ActiveMQ Artemis need plugins or specific
+// configurations. Without broker support, this option has no effect.
+func WithPublisherDedup() SendOption {
+	return func(s *sender) {
+		s.publisherDedup = true
+	}
+}
+
+// WithExpiryTimeFromExtension makes the sender set the AMQP absolute-expiry-time
+// of the messages from the expirytime extension of the event, if any, so that
+// the broker can discard the expired events. If the absolute-expiry-time is
+// also derived WithTTLFromContext, the earliest time is used.
+func WithExpiryTimeFromExtension() SendOption {
+	return func(s *sender) {
+		s.expiryTimeFromExtension = true
+	}
+}
+
+// WithSenderPropertyPrefix makes the sender use prefix in place of "cloudEvents:"
+// for the names of the application properties holding the attributes and the
+// extensions of the events sent in binary mode, e.g. for interoperability with
+// consumers expecting a different prefix. The receiver must be configured
+// WithReceiverPropertyPrefix with the same prefix.
+func WithSenderPropertyPrefix(prefix string) SendOption {
+	return func(s *sender) {
+		s.propertyPrefix = prefix
+	}
+}
+
+// ReceiveOption is the type of amqp receiver options
+type ReceiveOption func(*receiver)
+
+// Disposition is the outcome a DispositionFunc can select for a received message.
+type Disposition int
+
+const (
+	// DispositionNone leaves the settlement of the message to the handler, through Message.Finish.
+	DispositionNone Disposition = iota
+	// DispositionAccept accepts the message before it reaches the handler.
+	DispositionAccept
+	// DispositionReject rejects the message before it reaches the handler.
+	DispositionReject
+	// DispositionRelease releases the message before it reaches the handler,
+	// so the broker can redeliver it.
+	DispositionRelease
+)
+
+// DispositionFunc decides how a received message should be settled
+// before it reaches the handler.
+type DispositionFunc func(ctx context.Context, m binding.Message) Disposition
+
+// WithDispositionFunc configures a DispositionFunc invoked right after a message is received.
+// If fn returns a Disposition other than DispositionNone, the message is settled accordingly
+// and Receive moves to the next message, so the settled message never reaches the handler.
+func WithDispositionFunc(fn DispositionFunc) ReceiveOption {
+	return func(r *receiver) {
+		r.dispositionFunc = fn
+	}
+}
+
+// WithChunkReassembly makes the receiver reassemble the messages split by a
+// sender configured WithMaxMessageSize, returning a single message once all its
+// chunks are received. Finishing the reassembled message settles all its chunks.
+// The chunks of a message not completely received within timeout from its first
+// chunk are rejected when the next chunk is received.
+func WithChunkReassembly(timeout time.Duration) ReceiveOption {
+	return func(r *receiver) {
+		r.reassembler = newReassembler(timeout)
+	}
+}
+
+// WithExpiryTimeExtension makes the receiver surface the AMQP absolute-expiry-time
+// of the received messages as the expirytime extension of the event, unless the
+// event already has it. This is the reverse of WithExpiryTimeFromExtension.
+func WithExpiryTimeExtension() ReceiveOption {
+	return func(r *receiver) {
+		r.expiryTimeFromAMQP = true
+	}
+}
+
+// WithReceiverPropertyPrefix makes the receiver read the attributes and the
+// extensions of the messages in binary mode from the application properties
+// with prefix, as sent WithSenderPropertyPrefix. The properties with the
+// default "cloudEvents:" prefix are still recognized, unless the same
+// property with prefix is present.
+func WithReceiverPropertyPrefix(prefix string) ReceiveOption {
+	return func(r *receiver) {
+		r.propertyPrefix = prefix
+	}
+}
+
+// WithTypeFilter makes the receiver discard the events whose type is not one of
+// types: they are accepted, so the broker doesn't redeliver them, and Receive
+// moves to the next message. The type is read from the application properties
+// in binary mode and, in structured mode, without decoding the data of the JSON
+// format. The messages whose type can't be read, e.g. malformed events, are
+// returned by Receive, so the handler sees the error.
+func WithTypeFilter(types ...string) ReceiveOption {
+	return func(r *receiver) {
+		r.typeFilter = make(map[string]bool, len(types))
+		for _, t := range types {
+			r.typeFilter[t] = true
+		}
+	}
+}
+
+// ProtocolOption is the type of amqp Protocol options
+type ProtocolOption func(*protocolOptions)
+
+type protocolOptions struct {
+	sendOptions    []SendOption
+	receiveOptions []ReceiveOption
+}
+
+// WithSendOptions configures the sender of the Protocol with opts.
+func WithSendOptions(opts ...SendOption) ProtocolOption {
+	return func(o *protocolOptions) {
+		o.sendOptions = append(o.sendOptions, opts...)
+	}
+}
+
+// WithReceiveOptions configures the receiver of the Protocol with opts.
+func WithReceiveOptions(opts ...ReceiveOption) ProtocolOption {
+	return func(o *protocolOptions) {
+		o.receiveOptions = append(o.receiveOptions, opts...)
+	}
+}
+
+func newProtocolOptions(opts []ProtocolOption) protocolOptions {
+	var o protocolOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
@@ -0,0 +1,31 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package amqp
+
+import (
+	"testing"
+
+	"github.com/Azure/go-amqp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtocolOptions(t *testing.T) {
+	o := newProtocolOptions(nil)
+	require.Empty(t, o.sendOptions)
+	require.Empty(t, o.receiveOptions)
+
+	o = newProtocolOptions([]ProtocolOption{
+		WithSendOptions(WithTTLFromContext()),
+		WithSendOptions(WithPublisherDedup()),
+		WithReceiveOptions(WithTypeFilter("example.type")),
+	})
+	s := NewSender(nil, nil, o.sendOptions...).(*sender)
+	require.True(t, s.ttlFromContext)
+	require.True(t, s.publisherDedup)
+
+	r := newReceiver(nil, amqp.ReceiveOptions{}, o.receiveOptions...)
+	require.Equal(t, map[string]bool{"example.type": true}, r.typeFilter)
+}
@@ -30,6 +30,7 @@ type Protocol struct {
 }
 
 // NewProtocolFromClient creates a new amqp transport.
+// The sender and the receiver are configured WithSendOptions and WithReceiveOptions.
 func NewProtocolFromClient(
 	ctx context.Context,
 	client *amqp.Conn,
@@ -37,7 +38,9 @@ func NewProtocolFromClient(
 	queue string,
 	senderOptions amqp.SenderOptions,
 	receiverOptions amqp.ReceiverOptions,
+	opts ...ProtocolOption,
 ) (*Protocol, error) {
+	o := newProtocolOptions(opts)
 	t := &Protocol{
 		Node:    queue,
 		Client:  client,
@@ -51,18 +54,19 @@ func NewProtocolFromClient(
 		_ = session.Close(context.Background())
 		return nil, err
 	}
-	t.Sender = NewSender(amqpSender, &amqp.SendOptions{}).(*sender)
+	t.Sender = NewSender(amqpSender, &amqp.SendOptions{}, o.sendOptions...).(*sender)
 	t.SenderContextDecorators = []func(context.Context) context.Context{}
 
 	amqpReceiver, err := t.Session.NewReceiver(ctx, t.Node, &receiverOptions)
 	if err != nil {
 		return nil, err
 	}
-	t.Receiver = NewReceiver(amqpReceiver, amqp.ReceiveOptions{}).(*receiver)
+	t.Receiver = NewReceiver(amqpReceiver, amqp.ReceiveOptions{}, o.receiveOptions...).(*receiver)
 	return t, nil
 }
 
 // NewProtocol creates a new amqp transport.
+// The sender and the receiver are configured WithSendOptions and WithReceiveOptions.
 func NewProtocol(
 	ctx context.Context,
 	server, queue string,
@@ -70,6 +74,7 @@ func NewProtocol(
 	sessionOptions amqp.SessionOptions,
 	senderOptions amqp.SenderOptions,
 	receiverOptions amqp.ReceiverOptions,
+	opts ...ProtocolOption,
 ) (*Protocol, error) {
 	client, err := amqp.Dial(ctx, server, &connOptions)
 	if err != nil {
@@ -83,7 +88,7 @@ func NewProtocol(
 		return nil, err
 	}
 
-	p, err := NewProtocolFromClient(ctx, client, session, queue, senderOptions, receiverOptions)
+	p, err := NewProtocolFromClient(ctx, client, session, queue, senderOptions, receiverOptions, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -93,13 +98,16 @@ func NewProtocol(
 }
 
 // NewSenderProtocolFromClient creates a new amqp sender transport.
+// The sender is configured WithSendOptions.
 func NewSenderProtocolFromClient(
 	ctx context.Context,
 	client *amqp.Conn,
 	session *amqp.Session,
 	address string,
 	senderOptions amqp.SenderOptions,
+	opts ...ProtocolOption,
 ) (*Protocol, error) {
+	o := newProtocolOptions(opts)
 	t := &Protocol{
 		Node:    address,
 		Client:  client,
@@ -113,20 +121,23 @@ func NewSenderProtocolFromClient(
 		_ = session.Close(context.Background())
 		return nil, err
 	}
-	t.Sender = NewSender(amqpSender, &amqp.SendOptions{}).(*sender)
+	t.Sender = NewSender(amqpSender, &amqp.SendOptions{}, o.sendOptions...).(*sender)
 	t.SenderContextDecorators = []func(context.Context) context.Context{}
 
 	return t, nil
 }
 
 // NewReceiverProtocolFromClient creates a new receiver amqp transport.
+// The receiver is configured WithReceiveOptions.
 func NewReceiverProtocolFromClient(
 	ctx context.Context,
 	client *amqp.Conn,
 	session *amqp.Session,
 	address string,
 	receiverOptions amqp.ReceiverOptions,
+	opts ...ProtocolOption,
 ) (*Protocol, error) {
+	o := newProtocolOptions(opts)
 	t := &Protocol{
 		Node:    address,
 		Client:  client,
@@ -138,12 +149,13 @@ func NewReceiverProtocolFromClient(
 	if err != nil {
 		return nil, err
 	}
-	t.Receiver = NewReceiver(amqpReceiver, amqp.ReceiveOptions{}).(*receiver)
+	t.Receiver = NewReceiver(amqpReceiver, amqp.ReceiveOptions{}, o.receiveOptions...).(*receiver)
 	return t, nil
 }
 
 // NewSenderProtocol creates a new sender amqp transport.
-func NewSenderProtocol(ctx context.Context, server, address string, connOptions amqp.ConnOptions, sessionOptions amqp.SessionOptions, senderOptions amqp.SenderOptions) (*Protocol, error) {
+// The sender is configured WithSendOptions.
+func NewSenderProtocol(ctx context.Context, server, address string, connOptions amqp.ConnOptions, sessionOptions amqp.SessionOptions, senderOptions amqp.SenderOptions, opts ...ProtocolOption) (*Protocol, error) {
 	client, err := amqp.Dial(ctx, server, &connOptions)
 	if err != nil {
 		return nil, err
@@ -156,7 +168,7 @@ func NewSenderProtocol(ctx context.Context, server, address string, connOptions
 		return nil, err
 	}
 
-	p, err := NewSenderProtocolFromClient(ctx, client, session, address, senderOptions)
+	p, err := NewSenderProtocolFromClient(ctx, client, session, address, senderOptions, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -166,7 +178,8 @@ func NewSenderProtocol(ctx context.Context, server, address string, connOptions
 }
 
 // NewReceiverProtocol creates a new receiver amqp transport.
-func NewReceiverProtocol(ctx context.Context, server, address string, connOptions amqp.ConnOptions, sessionOptions amqp.SessionOptions, receiverOptions amqp.ReceiverOptions) (*Protocol, error) {
+// The receiver is configured WithReceiveOptions.
+func NewReceiverProtocol(ctx context.Context, server, address string, connOptions amqp.ConnOptions, sessionOptions amqp.SessionOptions, receiverOptions amqp.ReceiverOptions, opts ...ProtocolOption) (*Protocol, error) {
 	client, err := amqp.Dial(ctx, server, &connOptions)
 	if err != nil {
 		return nil, err
@@ -179,7 +192,7 @@ func NewReceiverProtocol(ctx context.Context, server, address string, connOption
 		return nil, err
 	}
 
-	p, err := NewReceiverProtocolFromClient(ctx, client, session, address, receiverOptions)
+	p, err := NewReceiverProtocolFromClient(ctx, client, session, address, receiverOptions, opts...)
 
 	if err != nil {
 		return nil, err
@@ -217,6 +230,12 @@ func (t *Protocol) Receive(ctx context.Context) (binding.Message, error) {
 	return t.Receiver.Receive(ctx)
 }
 
+// ReceiveN receives up to n messages, blocking until at least one is available.
+// The returned messages can be settled together with SettleAll or SettleEach.
+func (t *Protocol) ReceiveN(ctx context.Context, n int) ([]binding.Message, error) {
+	return t.Receiver.ReceiveN(ctx, n)
+}
+
 var _ protocol.Sender = (*Protocol)(nil)
 var _ protocol.Receiver = (*Protocol)(nil)
 var _ protocol.Closer = (*Protocol)(nil)
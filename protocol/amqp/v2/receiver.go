@@ -7,6 +7,8 @@ package amqp
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"strings"
 
@@ -18,29 +20,219 @@ import (
 
 const serverDown = "session ended by server"
 
+// ErrLinkDetached is returned by Receive when the broker detached the link,
+// e.g. because the queue was deleted or the link was idle for too long.
+// Unlike io.EOF, it's not a graceful shutdown: the receiver can be recreated
+// on a new link. The returned error wraps the *amqp.LinkError, with the
+// condition sent by the broker.
+var ErrLinkDetached = errors.New("amqp link detached by the broker")
+
+type linkDetachedError struct {
+	err *amqp.LinkError
+}
+
+func (e *linkDetachedError) Error() string {
+	return ErrLinkDetached.Error() + ": " + e.err.Error()
+}
+
+func (e *linkDetachedError) Is(target error) bool {
+	return target == ErrLinkDetached
+}
+
+func (e *linkDetachedError) Unwrap() error {
+	return e.err
+}
+
+// receiverLink is the subset of *amqp.Receiver methods used by receiver.
+type receiverLink interface {
+	settler
+	Receive(ctx context.Context, opts *amqp.ReceiveOptions) (*amqp.Message, error)
+	Prefetched() *amqp.Message
+	Close(ctx context.Context) error
+}
+
 // receiver wraps an amqp.Receiver as a binding.Receiver
 type receiver struct {
-	amqp    *amqp.Receiver
+	amqp    receiverLink
 	options amqp.ReceiveOptions
+
+	dispositionFunc    DispositionFunc
+	reassembler        *reassembler
+	expiryTimeFromAMQP bool
+	propertyPrefix     string
+	typeFilter         map[string]bool
 }
 
 func (r *receiver) Receive(ctx context.Context) (binding.Message, error) {
-	m, err := r.amqp.Receive(ctx, &r.options)
+	for {
+		m, err := r.amqp.Receive(ctx, &r.options)
+		if err != nil {
+			return nil, receiveError(ctx, err)
+		}
+
+		msg, err := r.process(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		if msg != nil {
+			return msg, nil
+		}
+	}
+}
+
+// receiveError maps the error returned by the link to io.EOF when the receiver
+// is shut down, either locally or because the session ended, and to an error
+// wrapping ErrLinkDetached when the broker detached the link.
+func receiveError(ctx context.Context, err error) error {
+	if err == ctx.Err() {
+		return io.EOF
+	}
+	var sessionErr *amqp.SessionError
+	if errors.As(err, &sessionErr) {
+		return io.EOF
+	}
+	var linkErr *amqp.LinkError
+	if errors.As(err, &linkErr) {
+		if linkErr.RemoteErr == nil {
+			// The link was closed locally
+			return io.EOF
+		}
+		return &linkDetachedError{err: linkErr}
+	}
+	// Older brokers or links, not returning typed errors, when the server goes down
+	if strings.HasPrefix(err.Error(), serverDown) {
+		return io.EOF
+	}
+	return err
+}
+
+// ReceiveN receives up to n messages, so that they can be processed and
+// settled together, e.g. with SettleAll or SettleEach.
+// ReceiveN blocks until a message is available, then returns it together with
+// the messages already prefetched by the link, without waiting for more.
+// The number of prefetched messages is bounded by the link credit, see
+// amqp.ReceiverOptions.Credit.
+func (r *receiver) ReceiveN(ctx context.Context, n int) ([]binding.Message, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("invalid batch size %d, expected a positive number", n)
+	}
+
+	first, err := r.Receive(ctx)
 	if err != nil {
-		if err == ctx.Err() {
-			return nil, io.EOF
+		return nil, err
+	}
+	msgs := make([]binding.Message, 0, n)
+	msgs = append(msgs, first)
+	for len(msgs) < n {
+		m := r.amqp.Prefetched()
+		if m == nil {
+			break
+		}
+		msg, err := r.process(ctx, m)
+		if err != nil {
+			return msgs, err
 		}
-		// handle case when server goes down
-		if strings.HasPrefix(err.Error(), serverDown) {
-			return nil, io.EOF
+		if msg != nil {
+			msgs = append(msgs, msg)
 		}
+	}
+	return msgs, nil
+}
+
+// process turns a received AMQP message into a Message. It returns nil if the
+// message was discarded by the type filter or settled by the DispositionFunc,
+// or if it is a chunk of a message whose chunks are not all received yet.
+func (r *receiver) process(ctx context.Context, m *amqp.Message) (*Message, error) {
+	var msg *Message
+	if r.reassembler != nil {
+		var err error
+		if msg, err = r.reassemble(ctx, m); err != nil || msg == nil {
+			return nil, err
+		}
+	} else {
+		msg = r.newMessage(m)
+	}
+	msg.expiryTimeFromAMQP = r.expiryTimeFromAMQP
+	msg.usePropertyPrefix(r.propertyPrefix)
+
+	if r.typeFilter != nil {
+		if typ, ok := msg.eventType(); ok && !r.typeFilter[typ] {
+			return nil, msg.settle(ctx, DispositionAccept, "")
+		}
+	}
+
+	if settled, err := r.dispose(ctx, msg); err != nil {
 		return nil, err
+	} else if settled {
+		return nil, nil
 	}
+	return msg, nil
+}
 
-	return NewMessage(m, r.amqp), nil
+func (r *receiver) newMessage(m *amqp.Message) *Message {
+	if rcv, ok := r.amqp.(*amqp.Receiver); ok {
+		return NewMessage(m, rcv)
+	}
+	return newMessage(m, r.amqp)
+}
+
+// reassemble collects m if it is a chunk, returning the reassembled message
+// once all its chunks are received. Invalid chunks, and the chunks of the
+// messages not reassembled before the timeout, are rejected.
+func (r *receiver) reassemble(ctx context.Context, m *amqp.Message) (*Message, error) {
+	id, index, count, ok, err := chunkInfo(m)
+	if !ok {
+		return r.newMessage(m), nil
+	}
+	if err != nil {
+		return nil, r.amqp.RejectMessage(ctx, m, &amqp.Error{Condition: condition, Description: err.Error()})
+	}
+
+	assembled, chunks, expired, err := r.reassembler.add(m, id, index, count)
+	for _, c := range expired {
+		if rejectErr := r.amqp.RejectMessage(ctx, c, &amqp.Error{Condition: condition, Description: "message chunks not received before the timeout"}); rejectErr != nil {
+			return nil, rejectErr
+		}
+	}
+	if err != nil {
+		return nil, r.amqp.RejectMessage(ctx, m, &amqp.Error{Condition: condition, Description: err.Error()})
+	}
+	if assembled == nil {
+		return nil, nil
+	}
+
+	msg := newMessage(assembled, &chunksSettler{link: r.amqp, chunks: chunks})
+	if rcv, ok := r.amqp.(*amqp.Receiver); ok {
+		msg.AMQPrcv = rcv
+	}
+	return msg, nil
+}
+
+// dispose invokes the DispositionFunc, if any, and settles msg accordingly.
+// It reports whether msg was settled, in which case it must not reach the handler.
+func (r *receiver) dispose(ctx context.Context, msg *Message) (bool, error) {
+	if r.dispositionFunc == nil {
+		return false, nil
+	}
+	d := r.dispositionFunc(ctx, msg)
+	if d == DispositionNone {
+		return false, nil
+	}
+	return true, msg.settle(ctx, d, "message rejected by the disposition func")
 }
 
 // NewReceiver create a new Receiver which wraps an amqp.Receiver in a binding.Receiver
-func NewReceiver(amqp *amqp.Receiver, options amqp.ReceiveOptions) protocol.Receiver {
-	return &receiver{amqp: amqp, options: options}
+// The number of messages prefetched by the link, i.e. the link credit, is set
+// when the link is attached, with amqp.ReceiverOptions.Credit: zero uses the
+// go-amqp default.
+func NewReceiver(amqp *amqp.Receiver, options amqp.ReceiveOptions, opts ...ReceiveOption) protocol.Receiver {
+	return newReceiver(amqp, options, opts...)
+}
+
+func newReceiver(amqp receiverLink, options amqp.ReceiveOptions, opts ...ReceiveOption) *receiver {
+	r := &receiver{amqp: amqp, options: options}
+	for _, o := range opts {
+		o(r)
+	}
+	return r
 }
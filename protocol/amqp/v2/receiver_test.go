@@ -0,0 +1,355 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package amqp
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-amqp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/client"
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/extensions"
+)
+
+type fakeReceiverLink struct {
+	messages []*amqp.Message
+	errs     []error
+
+	accepted []*amqp.Message
+	rejected []*amqp.Message
+	released []*amqp.Message
+}
+
+func (f *fakeReceiverLink) Receive(ctx context.Context, opts *amqp.ReceiveOptions) (*amqp.Message, error) {
+	if len(f.errs) > 0 {
+		err := f.errs[0]
+		f.errs = f.errs[1:]
+		return nil, err
+	}
+	if len(f.messages) == 0 {
+		return nil, ctx.Err()
+	}
+	m := f.messages[0]
+	f.messages = f.messages[1:]
+	return m, nil
+}
+
+func (f *fakeReceiverLink) Prefetched() *amqp.Message {
+	if len(f.errs) > 0 || len(f.messages) == 0 {
+		return nil
+	}
+	m := f.messages[0]
+	f.messages = f.messages[1:]
+	return m
+}
+
+func (f *fakeReceiverLink) AcceptMessage(ctx context.Context, msg *amqp.Message) error {
+	f.accepted = append(f.accepted, msg)
+	return nil
+}
+
+func (f *fakeReceiverLink) RejectMessage(ctx context.Context, msg *amqp.Message, e *amqp.Error) error {
+	f.rejected = append(f.rejected, msg)
+	return nil
+}
+
+func (f *fakeReceiverLink) ReleaseMessage(ctx context.Context, msg *amqp.Message) error {
+	f.released = append(f.released, msg)
+	return nil
+}
+
+func (f *fakeReceiverLink) Close(ctx context.Context) error {
+	return nil
+}
+
+func TestReceiver_WithDispositionFunc(t *testing.T) {
+	accept := amqp.NewMessage([]byte("accept"))
+	reject := amqp.NewMessage([]byte("reject"))
+	release := amqp.NewMessage([]byte("release"))
+	handle := amqp.NewMessage([]byte("handle"))
+
+	link := &fakeReceiverLink{messages: []*amqp.Message{accept, reject, release, handle}}
+	r := newReceiver(link, amqp.ReceiveOptions{}, WithDispositionFunc(func(ctx context.Context, m binding.Message) Disposition {
+		switch string(m.(*Message).AMQP.GetData()) {
+		case "accept":
+			return DispositionAccept
+		case "reject":
+			return DispositionReject
+		case "release":
+			return DispositionRelease
+		}
+		return DispositionNone
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	got, err := r.Receive(ctx)
+	require.NoError(t, err)
+	require.Same(t, handle, got.(*Message).AMQP)
+
+	require.Equal(t, []*amqp.Message{accept}, link.accepted)
+	require.Equal(t, []*amqp.Message{reject}, link.rejected)
+	require.Equal(t, []*amqp.Message{release}, link.released)
+
+	// The message that reached the handler is left unsettled
+	require.NoError(t, got.Finish(nil))
+	require.Equal(t, []*amqp.Message{accept, handle}, link.accepted)
+
+	cancel()
+	_, err = r.Receive(ctx)
+	require.Equal(t, io.EOF, err)
+}
+
+func TestReceiver_DefaultLeavesSettlementToHandler(t *testing.T) {
+	m := amqp.NewMessage([]byte("hello"))
+	link := &fakeReceiverLink{messages: []*amqp.Message{m}}
+	r := newReceiver(link, amqp.ReceiveOptions{})
+
+	got, err := r.Receive(context.Background())
+	require.NoError(t, err)
+	require.Same(t, m, got.(*Message).AMQP)
+	require.Empty(t, link.accepted)
+	require.Empty(t, link.rejected)
+	require.Empty(t, link.released)
+}
+
+func TestReceiver_WithExpiryTimeExtension(t *testing.T) {
+	expiry := time.Date(2020, 3, 21, 12, 0, 0, 0, time.UTC)
+	newAMQPMessage := func(t *testing.T, ctx context.Context, ext interface{}) *amqp.Message {
+		e := event.New()
+		e.SetID("event-id")
+		e.SetType("unit.test")
+		e.SetSource("/unit/test")
+		if ext != nil {
+			e.SetExtension(extensions.ExpiryTimeExtension, ext)
+		}
+		var m amqp.Message
+		require.NoError(t, WriteMessage(ctx, binding.ToMessage(&e), &m))
+		m.Properties.AbsoluteExpiryTime = &expiry
+		return &m
+	}
+	receive := func(t *testing.T, m *amqp.Message, opts ...ReceiveOption) *event.Event {
+		r := newReceiver(&fakeReceiverLink{messages: []*amqp.Message{m}}, amqp.ReceiveOptions{}, opts...)
+		msg, err := r.Receive(context.Background())
+		require.NoError(t, err)
+		e, err := binding.ToEvent(context.Background(), msg)
+		require.NoError(t, err)
+		return e
+	}
+
+	for name, ctx := range map[string]context.Context{
+		"binary":     binding.WithForceBinary(context.Background()),
+		"structured": binding.WithForceStructured(context.Background()),
+	} {
+		t.Run(name, func(t *testing.T) {
+			e := receive(t, newAMQPMessage(t, ctx, nil), WithExpiryTimeExtension())
+			got, ok := extensions.GetExpiryTime(*e)
+			require.True(t, ok)
+			require.True(t, expiry.Equal(got))
+			require.Equal(t, "event-id", e.ID())
+		})
+
+		t.Run(name+" keeps the event expirytime", func(t *testing.T) {
+			own := expiry.Add(time.Hour)
+			e := receive(t, newAMQPMessage(t, ctx, own), WithExpiryTimeExtension())
+			got, ok := extensions.GetExpiryTime(*e)
+			require.True(t, ok)
+			require.True(t, own.Equal(got))
+		})
+
+		t.Run(name+" disabled", func(t *testing.T) {
+			e := receive(t, newAMQPMessage(t, ctx, nil))
+			_, ok := extensions.GetExpiryTime(*e)
+			require.False(t, ok)
+		})
+	}
+
+	t.Run("GetExtension", func(t *testing.T) {
+		r := newReceiver(&fakeReceiverLink{messages: []*amqp.Message{newAMQPMessage(t, binding.WithForceBinary(context.Background()), nil)}},
+			amqp.ReceiveOptions{}, WithExpiryTimeExtension())
+		msg, err := r.Receive(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, expiry, msg.(*Message).GetExtension(extensions.ExpiryTimeExtension))
+	})
+}
+
+func TestReceiver_WithTypeFilter(t *testing.T) {
+	newAMQPMessage := func(t *testing.T, ctx context.Context, typ string) *amqp.Message {
+		e := event.New()
+		e.SetID("event-id")
+		e.SetType(typ)
+		e.SetSource("/unit/test")
+		require.NoError(t, e.SetData(event.ApplicationJSON, map[string]string{"type": "not.the.type"}))
+		var m amqp.Message
+		require.NoError(t, WriteMessage(ctx, binding.ToMessage(&e), &m))
+		return &m
+	}
+
+	for name, ctx := range map[string]context.Context{
+		"binary":     binding.WithForceBinary(context.Background()),
+		"structured": binding.WithForceStructured(context.Background()),
+	} {
+		t.Run(name, func(t *testing.T) {
+			match1 := newAMQPMessage(t, ctx, "unit.match")
+			other1 := newAMQPMessage(t, ctx, "unit.other")
+			match2 := newAMQPMessage(t, ctx, "unit.match.too")
+			other2 := newAMQPMessage(t, ctx, "not.the.type")
+			notEvent := amqp.NewMessage([]byte("not an event"))
+			link := &fakeReceiverLink{messages: []*amqp.Message{other1, match1, other2, match2, notEvent}}
+			r := newReceiver(link, amqp.ReceiveOptions{}, WithTypeFilter("unit.match", "unit.match.too"))
+
+			for _, want := range []*amqp.Message{match1, match2, notEvent} {
+				got, err := r.Receive(context.Background())
+				require.NoError(t, err)
+				require.Same(t, want, got.(*Message).AMQP)
+			}
+			require.Equal(t, []*amqp.Message{other1, other2}, link.accepted)
+			require.Empty(t, link.rejected)
+		})
+	}
+
+	t.Run("ReceiveN", func(t *testing.T) {
+		match := newAMQPMessage(t, context.Background(), "unit.match")
+		other := newAMQPMessage(t, context.Background(), "unit.other")
+		link := &fakeReceiverLink{messages: []*amqp.Message{other, match, other, match}}
+		r := newReceiver(link, amqp.ReceiveOptions{}, WithTypeFilter("unit.match"))
+
+		got, err := r.ReceiveN(context.Background(), 3)
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		require.Equal(t, []*amqp.Message{other, other}, link.accepted)
+	})
+}
+
+func TestReceiver_ReceiveErrors(t *testing.T) {
+	otherErr := errors.New("other")
+	detached := &amqp.LinkError{RemoteErr: &amqp.Error{Condition: amqp.ErrCondDetachForced, Description: "queue deleted"}}
+
+	for name, tc := range map[string]struct {
+		err          error
+		wantEOF      bool
+		wantDetached bool
+	}{
+		"session ended":        {err: &amqp.SessionError{}, wantEOF: true},
+		"session ended remote": {err: &amqp.SessionError{RemoteErr: &amqp.Error{Condition: amqp.ErrCondInternalError}}, wantEOF: true},
+		"link closed locally":  {err: &amqp.LinkError{}, wantEOF: true},
+		"link detached":        {err: detached, wantDetached: true},
+		"server down":          {err: errors.New(serverDown + ": reason"), wantEOF: true},
+		"other error":          {err: otherErr},
+	} {
+		t.Run(name, func(t *testing.T) {
+			r := newReceiver(&fakeReceiverLink{errs: []error{tc.err}}, amqp.ReceiveOptions{})
+			_, err := r.Receive(context.Background())
+			require.Equal(t, tc.wantEOF, err == io.EOF)
+			require.Equal(t, tc.wantDetached, errors.Is(err, ErrLinkDetached))
+			if tc.wantDetached {
+				var linkErr *amqp.LinkError
+				require.ErrorAs(t, err, &linkErr)
+				require.Equal(t, amqp.ErrCondDetachForced, linkErr.RemoteErr.Condition)
+				require.Contains(t, err.Error(), "queue deleted")
+			}
+			if !tc.wantEOF && !tc.wantDetached {
+				require.Equal(t, otherErr, err)
+			}
+		})
+	}
+}
+
+func TestReceiver_FinishSettles(t *testing.T) {
+	ok := amqp.NewMessage([]byte("ok"))
+	failed := amqp.NewMessage([]byte("failed"))
+	link := &fakeReceiverLink{messages: []*amqp.Message{ok, failed}}
+	r := newReceiver(link, amqp.ReceiveOptions{})
+
+	got, err := r.Receive(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, got.Finish(nil))
+	require.Equal(t, []*amqp.Message{ok}, link.accepted)
+	require.Empty(t, link.rejected)
+
+	got, err = r.Receive(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, got.Finish(errors.New("handler failure")))
+	require.Equal(t, []*amqp.Message{ok}, link.accepted)
+	require.Equal(t, []*amqp.Message{failed}, link.rejected)
+	require.Empty(t, link.released)
+}
+
+// singleMessageReceiver returns the first message of the receiver, then blocks
+// until the context is done.
+type singleMessageReceiver struct {
+	*receiver
+	received bool
+}
+
+func (r *singleMessageReceiver) Receive(ctx context.Context) (binding.Message, error) {
+	if !r.received {
+		r.received = true
+		return r.receiver.Receive(ctx)
+	}
+	<-ctx.Done()
+	return nil, io.EOF
+}
+
+func TestReceiver_ClientFinishAccepts(t *testing.T) {
+	e := event.New()
+	e.SetID("id")
+	e.SetSource("source")
+	e.SetType("type")
+	var m amqp.Message
+	require.NoError(t, WriteMessage(context.Background(), binding.ToMessage(&e), &m))
+
+	link := &fakeReceiverLink{messages: []*amqp.Message{&m}}
+	c, err := client.New(&singleMessageReceiver{receiver: newReceiver(link, amqp.ReceiveOptions{})}, client.WithPollGoroutines(1))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var got event.Event
+	require.NoError(t, c.StartReceiver(ctx, func(e event.Event) {
+		got = e
+		cancel()
+	}))
+	require.Equal(t, "id", got.ID())
+	// Finish(nil) is called by the client once the event is handled
+	require.Equal(t, []*amqp.Message{&m}, link.accepted)
+	require.Empty(t, link.rejected)
+}
+
+func TestReceiver_ClientFilteredEventAccepted(t *testing.T) {
+	e := event.New()
+	e.SetID("id")
+	e.SetSource("source")
+	e.SetType("type")
+	var m amqp.Message
+	require.NoError(t, WriteMessage(context.Background(), binding.ToMessage(&e), &m))
+
+	link := &fakeReceiverLink{messages: []*amqp.Message{&m}}
+	c, err := client.New(&singleMessageReceiver{receiver: newReceiver(link, amqp.ReceiveOptions{})},
+		client.WithPollGoroutines(1),
+		client.WithBlockingCallback(),
+		client.WithEventFilter(func(context.Context, event.Event) bool { return false }),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	invoked := false
+	require.NoError(t, c.StartReceiver(ctx, func(e event.Event) {
+		invoked = true
+	}))
+	require.False(t, invoked)
+	// the filtered out event is dropped, but still accepted
+	require.Equal(t, []*amqp.Message{&m}, link.accepted)
+	require.Empty(t, link.rejected)
+}
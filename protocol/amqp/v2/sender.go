@@ -7,17 +7,36 @@ package amqp
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/Azure/go-amqp"
 
 	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/binding/format"
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/extensions"
 	"github.com/cloudevents/sdk-go/v2/protocol"
+	"github.com/cloudevents/sdk-go/v2/types"
 )
 
+// senderLink is the subset of *amqp.Sender methods used by sender.
+type senderLink interface {
+	Send(ctx context.Context, msg *amqp.Message, opts *amqp.SendOptions) error
+	Close(ctx context.Context) error
+}
+
 // sender wraps an amqp.Sender as a binding.Sender
 type sender struct {
-	amqp    *amqp.Sender
+	amqp    senderLink
 	options *amqp.SendOptions
+
+	ttlFromContext          bool
+	maxMessageSize          int
+	publisherDedup          bool
+	expiryTimeFromExtension bool
+	propertyPrefix          string
 }
 
 func (s *sender) Send(ctx context.Context, in binding.Message, transformers ...binding.Transformer) error {
@@ -34,13 +53,158 @@ func (s *sender) Send(ctx context.Context, in binding.Message, transformers ...b
 		return err
 	}
 
+	if s.ttlFromContext {
+		setTTLFromContext(ctx, &amqpMessage)
+	}
+	if s.expiryTimeFromExtension {
+		if err = setExpiryTimeFromEvent(&amqpMessage); err != nil {
+			return err
+		}
+	}
+	if s.publisherDedup {
+		if err = setMessageIDFromEvent(&amqpMessage); err != nil {
+			return err
+		}
+	}
+	if s.propertyPrefix != "" {
+		setPropertyPrefix(&amqpMessage, s.propertyPrefix)
+	}
+
+	if s.maxMessageSize > 0 {
+		var chunks []*amqp.Message
+		if chunks, err = chunkMessage(&amqpMessage, s.maxMessageSize); err != nil {
+			return err
+		}
+		for _, chunk := range chunks {
+			if err = s.amqp.Send(ctx, chunk, s.options); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
 	err = s.amqp.Send(ctx, &amqpMessage, s.options)
 	return err
 }
 
 // NewSender creates a new Sender which wraps an amqp.Sender in a binding.Sender
-func NewSender(amqpSender *amqp.Sender, options *amqp.SendOptions) protocol.Sender {
+func NewSender(amqpSender *amqp.Sender, options *amqp.SendOptions, opts ...SendOption) protocol.Sender {
 	s := &sender{amqp: amqpSender, options: options}
+	for _, o := range opts {
+		o(s)
+	}
 
 	return s
 }
+
+// setTTLFromContext sets the AMQP message TTL and absolute expiry time from
+// the remaining time before the context deadline.
+func setTTLFromContext(ctx context.Context, amqpMessage *amqp.Message) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return
+	}
+	ttl := time.Until(deadline)
+	if ttl <= 0 {
+		// The context is already expired, Send is going to fail anyway
+		return
+	}
+
+	if amqpMessage.Header == nil {
+		// 4 is the default priority defined by the AMQP spec
+		amqpMessage.Header = &amqp.MessageHeader{Priority: 4}
+	}
+	amqpMessage.Header.TTL = ttl
+
+	if amqpMessage.Properties == nil {
+		amqpMessage.Properties = &amqp.MessageProperties{}
+	}
+	amqpMessage.Properties.AbsoluteExpiryTime = &deadline
+}
+
+// setExpiryTimeFromEvent sets the AMQP absolute-expiry-time to the expirytime
+// extension of the event written in amqpMessage, if any. When the absolute
+// expiry time is already set, e.g. WithTTLFromContext, the earliest one is kept.
+func setExpiryTimeFromEvent(amqpMessage *amqp.Message) error {
+	v, err := eventExpiryTime(amqpMessage)
+	if err != nil || v == nil {
+		return err
+	}
+	expiry, err := types.ToTime(v)
+	if err != nil {
+		return fmt.Errorf("invalid %s extension: %w", extensions.ExpiryTimeExtension, err)
+	}
+	if amqpMessage.Properties == nil {
+		amqpMessage.Properties = &amqp.MessageProperties{}
+	}
+	if current := amqpMessage.Properties.AbsoluteExpiryTime; current == nil || expiry.Before(*current) {
+		amqpMessage.Properties.AbsoluteExpiryTime = &expiry
+	}
+	return nil
+}
+
+// eventExpiryTime returns the expirytime extension of the event written in
+// amqpMessage, either in binary or in structured mode, or nil if not set.
+func eventExpiryTime(amqpMessage *amqp.Message) (interface{}, error) {
+	if v, ok := amqpMessage.ApplicationProperties[prefix+extensions.ExpiryTimeExtension]; ok {
+		return v, nil
+	}
+	if amqpMessage.Properties != nil && amqpMessage.Properties.ContentType != nil {
+		if f := format.Lookup(*amqpMessage.Properties.ContentType); f != nil {
+			var e event.Event
+			if err := f.Unmarshal(amqpMessage.GetData(), &e); err != nil {
+				return nil, fmt.Errorf("cannot read the %s extension: %w", extensions.ExpiryTimeExtension, err)
+			}
+			return e.Extensions()[extensions.ExpiryTimeExtension], nil
+		}
+	}
+	return nil, nil
+}
+
+// setPropertyPrefix renames the application properties holding the attributes
+// and the extensions, to use p as prefix in place of the default one.
+func setPropertyPrefix(amqpMessage *amqp.Message, p string) {
+	properties := make(map[string]interface{}, len(amqpMessage.ApplicationProperties))
+	for k, v := range amqpMessage.ApplicationProperties {
+		if strings.HasPrefix(k, prefix) {
+			k = p + strings.TrimPrefix(k, prefix)
+		}
+		properties[k] = v
+	}
+	amqpMessage.ApplicationProperties = properties
+}
+
+// setMessageIDFromEvent sets the AMQP message-id to the id of the event
+// written in amqpMessage, unless the message-id is already set.
+func setMessageIDFromEvent(amqpMessage *amqp.Message) error {
+	if amqpMessage.Properties != nil && amqpMessage.Properties.MessageID != nil {
+		return nil
+	}
+	id, err := eventID(amqpMessage)
+	if err != nil {
+		return err
+	}
+	if amqpMessage.Properties == nil {
+		amqpMessage.Properties = &amqp.MessageProperties{}
+	}
+	amqpMessage.Properties.MessageID = id
+	return nil
+}
+
+// eventID returns the id of the event written in amqpMessage, either in binary
+// or in structured mode.
+func eventID(amqpMessage *amqp.Message) (string, error) {
+	if v, ok := amqpMessage.ApplicationProperties[prefix+"id"]; ok {
+		return types.ToString(v)
+	}
+	if amqpMessage.Properties != nil && amqpMessage.Properties.ContentType != nil {
+		if f := format.Lookup(*amqpMessage.Properties.ContentType); f != nil {
+			var e event.Event
+			if err := f.Unmarshal(amqpMessage.GetData(), &e); err != nil {
+				return "", fmt.Errorf("cannot read the event id: %w", err)
+			}
+			return e.ID(), nil
+		}
+	}
+	return "", fmt.Errorf("cannot find the event id in the message")
+}
@@ -0,0 +1,269 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package amqp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-amqp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/extensions"
+)
+
+func TestSetTTLFromContext(t *testing.T) {
+	t.Run("no deadline", func(t *testing.T) {
+		message := amqp.Message{}
+		setTTLFromContext(context.Background(), &message)
+		require.Nil(t, message.Header)
+		require.Nil(t, message.Properties)
+	})
+
+	t.Run("deadline", func(t *testing.T) {
+		deadline := time.Now().Add(10 * time.Second)
+		ctx, cancel := context.WithDeadline(context.Background(), deadline)
+		defer cancel()
+
+		message := amqp.Message{}
+		setTTLFromContext(ctx, &message)
+		require.NotNil(t, message.Header)
+		require.Greater(t, message.Header.TTL, time.Duration(0))
+		require.LessOrEqual(t, message.Header.TTL, 10*time.Second)
+		require.Equal(t, uint8(4), message.Header.Priority)
+		require.NotNil(t, message.Properties)
+		require.True(t, deadline.Equal(*message.Properties.AbsoluteExpiryTime))
+	})
+
+	t.Run("deadline with existing header", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		message := amqp.Message{Header: &amqp.MessageHeader{Durable: true, Priority: 9}}
+		setTTLFromContext(ctx, &message)
+		require.True(t, message.Header.Durable)
+		require.Equal(t, uint8(9), message.Header.Priority)
+		require.Greater(t, message.Header.TTL, 59*time.Second)
+	})
+
+	t.Run("expired deadline", func(t *testing.T) {
+		ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+		defer cancel()
+
+		message := amqp.Message{}
+		setTTLFromContext(ctx, &message)
+		require.Nil(t, message.Header)
+	})
+}
+
+func TestWithTTLFromContext(t *testing.T) {
+	s := NewSender(nil, nil).(*sender)
+	require.False(t, s.ttlFromContext)
+
+	s = NewSender(nil, nil, WithTTLFromContext()).(*sender)
+	require.True(t, s.ttlFromContext)
+}
+
+func TestWithPublisherDedup(t *testing.T) {
+	newEvent := func() event.Event {
+		e := event.New()
+		e.SetID("event-id")
+		e.SetType("unit.test")
+		e.SetSource("/unit/test")
+		require.NoError(t, e.SetData(event.TextPlain, "hello"))
+		return e
+	}
+
+	for name, ctx := range map[string]context.Context{
+		"binary":     binding.WithForceBinary(context.Background()),
+		"structured": binding.WithForceStructured(context.Background()),
+	} {
+		t.Run(name, func(t *testing.T) {
+			link := &fakeSenderLink{}
+			s := &sender{amqp: link}
+			WithPublisherDedup()(s)
+
+			// Retries of the same event have the same message-id
+			for i := 0; i < 2; i++ {
+				e := newEvent()
+				require.NoError(t, s.Send(ctx, binding.ToMessage(&e)))
+			}
+			require.Len(t, link.sent, 2)
+			for _, m := range link.sent {
+				require.Equal(t, "event-id", m.Properties.MessageID)
+			}
+		})
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		link := &fakeSenderLink{}
+		s := &sender{amqp: link}
+		e := newEvent()
+		require.NoError(t, s.Send(context.Background(), binding.ToMessage(&e)))
+		require.Nil(t, link.sent[0].Properties.MessageID)
+	})
+}
+
+func TestWithPublisherDedup_Chunks(t *testing.T) {
+	e := event.New()
+	e.SetID("event-id")
+	e.SetType("unit.test")
+	e.SetSource("/unit/test")
+	require.NoError(t, e.SetData(event.TextPlain, strings.Repeat("0123456789", 10)))
+
+	link := &fakeSenderLink{}
+	s := &sender{amqp: link}
+	WithPublisherDedup()(s)
+	WithMaxMessageSize(32)(s)
+	require.NoError(t, s.Send(binding.WithForceBinary(context.Background()), binding.ToMessage(&e)))
+
+	require.Greater(t, len(link.sent), 1)
+	for i, m := range link.sent {
+		require.Equal(t, fmt.Sprintf("event-id/%d", i), m.Properties.MessageID)
+		require.Equal(t, "event-id", m.ApplicationProperties[chunkIDProperty])
+	}
+
+	rcvLink := &fakeReceiverLink{messages: link.sent}
+	r := newReceiver(rcvLink, amqp.ReceiveOptions{}, WithChunkReassembly(time.Minute))
+	msg, err := r.Receive(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "event-id", msg.(*Message).AMQP.Properties.MessageID)
+}
+
+func TestWithExpiryTimeFromExtension(t *testing.T) {
+	expiry := time.Now().Add(time.Hour).UTC().Truncate(time.Millisecond)
+	newEvent := func() event.Event {
+		e := event.New()
+		e.SetID("event-id")
+		e.SetType("unit.test")
+		e.SetSource("/unit/test")
+		extensions.SetExpiryTime(&e, expiry)
+		return e
+	}
+
+	for name, ctx := range map[string]context.Context{
+		"binary":     binding.WithForceBinary(context.Background()),
+		"structured": binding.WithForceStructured(context.Background()),
+	} {
+		t.Run(name, func(t *testing.T) {
+			link := &fakeSenderLink{}
+			s := &sender{amqp: link}
+			WithExpiryTimeFromExtension()(s)
+
+			e := newEvent()
+			require.NoError(t, s.Send(ctx, binding.ToMessage(&e)))
+			require.True(t, expiry.Equal(*link.sent[0].Properties.AbsoluteExpiryTime))
+
+			// The received message has the same expirytime
+			r := newReceiver(&fakeReceiverLink{messages: link.sent}, amqp.ReceiveOptions{}, WithExpiryTimeExtension())
+			msg, err := r.Receive(context.Background())
+			require.NoError(t, err)
+			got, err := binding.ToEvent(context.Background(), msg)
+			require.NoError(t, err)
+			gotExpiry, ok := extensions.GetExpiryTime(*got)
+			require.True(t, ok)
+			require.True(t, expiry.Equal(gotExpiry))
+		})
+	}
+
+	t.Run("earlier context deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		deadline, _ := ctx.Deadline()
+
+		link := &fakeSenderLink{}
+		s := &sender{amqp: link}
+		WithTTLFromContext()(s)
+		WithExpiryTimeFromExtension()(s)
+		e := newEvent()
+		require.NoError(t, s.Send(ctx, binding.ToMessage(&e)))
+		require.True(t, deadline.Equal(*link.sent[0].Properties.AbsoluteExpiryTime))
+	})
+
+	t.Run("no expirytime", func(t *testing.T) {
+		link := &fakeSenderLink{}
+		s := &sender{amqp: link}
+		WithExpiryTimeFromExtension()(s)
+		e := newEvent()
+		e.SetExtension(extensions.ExpiryTimeExtension, nil)
+		require.NoError(t, s.Send(context.Background(), binding.ToMessage(&e)))
+		require.Nil(t, link.sent[0].Properties.AbsoluteExpiryTime)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		link := &fakeSenderLink{}
+		s := &sender{amqp: link}
+		e := newEvent()
+		require.NoError(t, s.Send(context.Background(), binding.ToMessage(&e)))
+		require.Nil(t, link.sent[0].Properties.AbsoluteExpiryTime)
+	})
+}
+
+func TestWithPropertyPrefix(t *testing.T) {
+	e := event.New()
+	e.SetID("event-id")
+	e.SetType("unit.test")
+	e.SetSource("/unit/test")
+	e.SetSubject("subject")
+	e.SetExtension("myext", "value")
+	require.NoError(t, e.SetData(event.TextPlain, "hello"))
+
+	link := &fakeSenderLink{}
+	s := &sender{amqp: link}
+	WithSenderPropertyPrefix("ce_")(s)
+	require.NoError(t, s.Send(binding.WithForceBinary(context.Background()), binding.ToMessage(&e)))
+
+	sent := link.sent[0]
+	require.Equal(t, map[string]interface{}{
+		"ce_specversion": "1.0",
+		"ce_id":          "event-id",
+		"ce_type":        "unit.test",
+		"ce_source":      "/unit/test",
+		"ce_subject":     "subject",
+		"ce_myext":       "value",
+	}, sent.ApplicationProperties)
+
+	t.Run("round trip", func(t *testing.T) {
+		r := newReceiver(&fakeReceiverLink{messages: []*amqp.Message{sent}}, amqp.ReceiveOptions{}, WithReceiverPropertyPrefix("ce_"))
+		msg, err := r.Receive(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, binding.EncodingBinary, msg.ReadEncoding())
+		got, err := binding.ToEvent(context.Background(), msg)
+		require.NoError(t, err)
+		require.Equal(t, e.String(), got.String())
+		require.Equal(t, "value", msg.(binding.MessageMetadataReader).GetExtension("myext"))
+	})
+
+	t.Run("default prefix fallback", func(t *testing.T) {
+		m := amqp.NewMessage([]byte("hello"))
+		m.ApplicationProperties = map[string]interface{}{
+			"cloudEvents:specversion": "1.0",
+			"cloudEvents:id":          "event-id",
+			"cloudEvents:type":        "unit.test",
+			"ce_source":               "/unit/test",
+			"cloudEvents:source":      "/ignored",
+		}
+		r := newReceiver(&fakeReceiverLink{messages: []*amqp.Message{m}}, amqp.ReceiveOptions{}, WithReceiverPropertyPrefix("ce_"))
+		msg, err := r.Receive(context.Background())
+		require.NoError(t, err)
+		got, err := binding.ToEvent(context.Background(), msg)
+		require.NoError(t, err)
+		require.Equal(t, "event-id", got.ID())
+		require.Equal(t, "/unit/test", got.Source())
+	})
+
+	t.Run("receiver without the prefix", func(t *testing.T) {
+		r := newReceiver(&fakeReceiverLink{messages: []*amqp.Message{sent}}, amqp.ReceiveOptions{})
+		msg, err := r.Receive(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, binding.EncodingUnknown, msg.ReadEncoding())
+	})
+}
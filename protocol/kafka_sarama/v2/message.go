@@ -14,6 +14,7 @@ import (
 	"github.com/cloudevents/sdk-go/v2/binding"
 	"github.com/cloudevents/sdk-go/v2/binding/format"
 	"github.com/cloudevents/sdk-go/v2/binding/spec"
+	"github.com/cloudevents/sdk-go/v2/event"
 
 	"github.com/IBM/sarama"
 )
@@ -144,6 +145,33 @@ func (m *Message) GetExtension(name string) interface{} {
 	return string(m.Headers[prefix+name])
 }
 
+// setPartitionKey sets the partitionkey extension of m to key, unless m
+// already has it or key is empty. A structured message which can't be decoded
+// is left as is, to be reported as malformed when converted to an event.
+func setPartitionKey(m *Message, key []byte) {
+	if len(key) == 0 {
+		return
+	}
+	switch {
+	case m.version != nil:
+		if _, ok := m.Headers[prefix+partitionKey]; !ok {
+			m.Headers[prefix+partitionKey] = key
+		}
+	case m.format != nil:
+		var e event.Event
+		if err := m.format.Unmarshal(m.Value, &e); err != nil {
+			return
+		}
+		if _, ok := e.Extensions()[partitionKey]; ok {
+			return
+		}
+		e.SetExtension(partitionKey, string(key))
+		if value, err := m.format.Marshal(&e); err == nil {
+			m.Value = value
+		}
+	}
+}
+
 func (m *Message) Finish(error) error {
 	return nil
 }
@@ -26,3 +26,12 @@ func WithSenderContextDecorators(decorator func(context.Context) context.Context
 		protocol.SenderContextDecorators = append(protocol.SenderContextDecorators, decorator)
 	}
 }
+
+// WithReceiverKeyMapping makes the receiver expose the key of the consumed
+// messages as the partitionkey extension of the events, unless they already
+// have it. This is the reverse of the key mapping done by WriteProducerMessage.
+func WithReceiverKeyMapping() ProtocolOptionFunc {
+	return func(protocol *Protocol) {
+		protocol.receiverKeyMapping = true
+	}
+}
@@ -38,8 +38,9 @@ type Protocol struct {
 	consumerMux sync.Mutex
 
 	// Consumer options
-	receiverTopic   string
-	receiverGroupId string
+	receiverTopic      string
+	receiverGroupId    string
+	receiverKeyMapping bool
 }
 
 // NewProtocol creates a new kafka transport.
@@ -87,6 +88,7 @@ func NewProtocolFromClient(client sarama.Client, sendToTopic string, receiveFrom
 		return nil, errors.New("you didn't specify the topic to receive from")
 	}
 	p.Consumer = NewConsumerFromClient(p.Client, p.receiverGroupId, p.receiverTopic)
+	p.Consumer.keyMapping = p.receiverKeyMapping
 
 	return p, nil
 }
@@ -25,6 +25,9 @@ type msgErr struct {
 type Receiver struct {
 	once     sync.Once
 	incoming chan msgErr
+
+	// keyMapping is set WithReceiverKeyMapping
+	keyMapping bool
 }
 
 // NewReceiver creates a Receiver which implements sarama.ConsumerGroupHandler
@@ -66,6 +69,9 @@ func (r *Receiver) ConsumeClaim(session sarama.ConsumerGroupSession, claim saram
 				return nil
 			}
 			m := NewMessageFromConsumerMessage(msg)
+			if r.keyMapping {
+				setPartitionKey(m, msg.Key)
+			}
 			msgErrObj := msgErr{
 				msg: binding.WithFinish(m, func(err error) {
 					if protocol.IsACK(err) {
@@ -0,0 +1,103 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package kafka_sarama
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/test"
+)
+
+type consumerGroupSessionMock struct {
+	sarama.ConsumerGroupSession
+	ctx context.Context
+}
+
+func (s *consumerGroupSessionMock) Context() context.Context {
+	return s.ctx
+}
+
+func (s *consumerGroupSessionMock) MarkMessage(*sarama.ConsumerMessage, string) {}
+
+type consumerGroupClaimMock struct {
+	sarama.ConsumerGroupClaim
+	messages chan *sarama.ConsumerMessage
+}
+
+func (c *consumerGroupClaimMock) Messages() <-chan *sarama.ConsumerMessage {
+	return c.messages
+}
+
+func TestReceiver_KeyMapping(t *testing.T) {
+	newConsumerMessage := func(t *testing.T, ctx context.Context, e event.Event, key string) *sarama.ConsumerMessage {
+		var pm sarama.ProducerMessage
+		require.NoError(t, WriteProducerMessage(WithSkipKeyMapping(ctx), binding.ToMessage(&e), &pm))
+		cm := &sarama.ConsumerMessage{Key: []byte(key), Topic: "topic"}
+		if pm.Value != nil {
+			value, err := pm.Value.Encode()
+			require.NoError(t, err)
+			cm.Value = value
+		}
+		for i := range pm.Headers {
+			cm.Headers = append(cm.Headers, &pm.Headers[i])
+		}
+		return cm
+	}
+	receive := func(t *testing.T, keyMapping bool, cm *sarama.ConsumerMessage) *event.Event {
+		r := NewReceiver()
+		r.keyMapping = keyMapping
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		claim := &consumerGroupClaimMock{messages: make(chan *sarama.ConsumerMessage, 1)}
+		claim.messages <- cm
+		close(claim.messages)
+		go func() {
+			_ = r.ConsumeClaim(&consumerGroupSessionMock{ctx: ctx}, claim)
+		}()
+
+		msg, err := r.Receive(ctx)
+		require.NoError(t, err)
+		e, err := binding.ToEvent(ctx, msg)
+		require.NoError(t, err)
+		return e
+	}
+
+	for name, ctx := range map[string]context.Context{
+		"binary":     binding.WithForceBinary(context.Background()),
+		"structured": binding.WithForceStructured(context.Background()),
+	} {
+		t.Run(name, func(t *testing.T) {
+			e := test.MinEvent()
+			got := receive(t, true, newConsumerMessage(t, ctx, e, "the-key"))
+			require.Equal(t, "the-key", got.Extensions()[partitionKey])
+		})
+
+		t.Run(name+" keeps the event partitionkey", func(t *testing.T) {
+			e := test.MinEvent()
+			e.SetExtension(partitionKey, "own-key")
+			got := receive(t, true, newConsumerMessage(t, ctx, e, "the-key"))
+			require.Equal(t, "own-key", got.Extensions()[partitionKey])
+		})
+
+		t.Run(name+" disabled", func(t *testing.T) {
+			e := test.MinEvent()
+			got := receive(t, false, newConsumerMessage(t, ctx, e, "the-key"))
+			require.NotContains(t, got.Extensions(), partitionKey)
+		})
+	}
+
+	t.Run("no key", func(t *testing.T) {
+		e := test.MinEvent()
+		got := receive(t, true, newConsumerMessage(t, binding.WithForceBinary(context.Background()), e, ""))
+		require.NotContains(t, got.Extensions(), partitionKey)
+	})
+}
@@ -37,10 +37,15 @@ var (
 	_ binding.MessageMetadataReader = (*Message)(nil)
 )
 
+// NewMessage returns a binding.Message wrapping msg. The messages with a payload
+// and no user properties, as published by MQTT 3.1.1 clients, are structured
+// with the JSON format, the only content mode of MQTT 3.1.1.
 func NewMessage(msg *paho.Publish) *Message {
 	var f format.Format
 	var v spec.Version
-	if msg.Properties != nil {
+	if (msg.Properties == nil || len(msg.Properties.User) == 0) && len(msg.Payload) > 0 {
+		f = format.JSON
+	} else if msg.Properties != nil {
 		// Use properties.User["Content-type"] to determine if message is structured
 		if s := msg.Properties.User.Get(contentType); format.IsFormat(s) {
 			f = format.Lookup(s)
@@ -64,3 +64,22 @@ func TestEncodeMQTTPubMessage(t *testing.T) {
 		}
 	})
 }
+
+func TestEncodeMQTTPubMessageWithoutUserProperties(t *testing.T) {
+	ctx := context.Background()
+	EachEvent(t, Events(), func(t *testing.T, e event.Event) {
+		eventIn := ConvertEventExtensionsToString(t, e.Clone())
+		pubMessage := &paho.Publish{}
+		err := WritePubMessage(binding.WithForceStructured(ctx), binding.ToMessage(&eventIn), pubMessage)
+		require.NoError(t, err)
+
+		// MQTT 3.1.1 messages have no properties
+		pubMessage.Properties = nil
+		messageOut := NewMessage(pubMessage)
+		require.Equal(t, binding.EncodingStructured, messageOut.ReadEncoding())
+
+		eventOut, err := binding.ToEvent(ctx, messageOut)
+		require.NoError(t, err)
+		AssertEventEquals(t, eventIn, *eventOut)
+	})
+}
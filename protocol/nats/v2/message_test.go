@@ -0,0 +1,88 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package nats
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/event"
+)
+
+func newTestEvent(t *testing.T) event.Event {
+	e := event.New()
+	e.SetID("id")
+	e.SetSource("/unit/test")
+	e.SetType("unit.test")
+	e.SetExtension("exstring", "hello")
+	if err := e.SetData(event.ApplicationJSON, map[string]string{"msg": "hello"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return e
+}
+
+func TestWriteMsgAndNewMessage(t *testing.T) {
+	want := newTestEvent(t)
+
+	var data bytes.Buffer
+	if err := WriteMsg(context.Background(), binding.ToMessage(&want), &data); err != nil {
+		t.Fatalf("WriteMsg() = %v", err)
+	}
+
+	m := NewMessage(&nats.Msg{Subject: "subject", Data: data.Bytes()})
+	if got := m.ReadEncoding(); got != binding.EncodingStructured {
+		t.Errorf("ReadEncoding() = %v, want %v", got, binding.EncodingStructured)
+	}
+	// The message can be read several times
+	for i := 0; i < 2; i++ {
+		got, err := binding.ToEvent(context.Background(), m)
+		if err != nil {
+			t.Fatalf("ToEvent() = %v", err)
+		}
+		if got.String() != want.String() {
+			t.Errorf("ToEvent() = %v, want %v", got, want)
+		}
+	}
+	if err := m.ReadBinary(context.Background(), nil); err != binding.ErrNotBinary {
+		t.Errorf("ReadBinary() = %v, want %v", err, binding.ErrNotBinary)
+	}
+}
+
+func TestReceiver(t *testing.T) {
+	want := newTestEvent(t)
+	var data bytes.Buffer
+	if err := WriteMsg(context.Background(), binding.ToMessage(&want), &data); err != nil {
+		t.Fatalf("WriteMsg() = %v", err)
+	}
+
+	r := NewReceiver()
+	go r.MsgHandler(&nats.Msg{Subject: "subject", Data: data.Bytes()})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	m, err := r.Receive(ctx)
+	if err != nil {
+		t.Fatalf("Receive() = %v", err)
+	}
+	got, err := binding.ToEvent(ctx, m)
+	if err != nil {
+		t.Fatalf("ToEvent() = %v", err)
+	}
+	if got.String() != want.String() {
+		t.Errorf("Receive() = %v, want %v", got, want)
+	}
+
+	cancel()
+	if _, err := r.Receive(ctx); err != io.EOF {
+		t.Errorf("Receive() = %v, want %v", err, io.EOF)
+	}
+}
@@ -42,10 +42,18 @@ func (b *pubsubMessagePublisher) SetStructuredEvent(ctx context.Context, f forma
 		return err
 	}
 	b.Data = buf.Bytes()
+	// The content type tells the receivers the message is structured
+	if b.Attributes == nil {
+		b.Attributes = make(map[string]string, 1)
+	}
+	b.Attributes[contentType] = f.MediaType()
 	return nil
 }
 
 func (b *pubsubMessagePublisher) Start(ctx context.Context) error {
+	if b.Attributes == nil {
+		b.Attributes = make(map[string]string)
+	}
 	return nil
 }
 
@@ -70,6 +78,7 @@ func (b *pubsubMessagePublisher) SetAttribute(attribute spec.Attribute, value in
 	if attribute.Kind() == spec.DataContentType {
 		if value == nil {
 			delete(b.Attributes, contentType)
+			return nil
 		}
 
 		// Everything is a string here
@@ -81,6 +90,7 @@ func (b *pubsubMessagePublisher) SetAttribute(attribute spec.Attribute, value in
 	} else {
 		if value == nil {
 			delete(b.Attributes, prefix+attribute.Name())
+			return nil
 		}
 
 		// Everything is a string here
@@ -96,6 +106,7 @@ func (b *pubsubMessagePublisher) SetAttribute(attribute spec.Attribute, value in
 func (b *pubsubMessagePublisher) SetExtension(name string, value interface{}) error {
 	if value == nil {
 		delete(b.Attributes, prefix+name)
+		return nil
 	}
 
 	// Store extensions as string attrs as well
@@ -0,0 +1,84 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package pubsub
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/pubsub"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/event"
+)
+
+func TestWritePubSubMessageRoundTrip(t *testing.T) {
+	want := event.New()
+	want.SetID("id")
+	want.SetSource("/unit/test")
+	want.SetType("unit.test")
+	want.SetExtension("exstring", "hello")
+	if err := want.SetData(event.ApplicationJSON, map[string]string{"msg": "hello"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		wantEncoding   binding.Encoding
+		wantAttributes map[string]string
+	}{
+		{
+			name:         "binary",
+			ctx:          binding.WithForceBinary(context.Background()),
+			wantEncoding: binding.EncodingBinary,
+			wantAttributes: map[string]string{
+				"ce-specversion": "1.0",
+				"ce-id":          "id",
+				"ce-source":      "/unit/test",
+				"ce-type":        "unit.test",
+				"ce-exstring":    "hello",
+				contentType:      event.ApplicationJSON,
+			},
+		},
+		{
+			name:         "structured",
+			ctx:          binding.WithForceStructured(context.Background()),
+			wantEncoding: binding.EncodingStructured,
+			wantAttributes: map[string]string{
+				contentType: event.ApplicationCloudEventsJSON,
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pm := &pubsub.Message{}
+			if err := WritePubSubMessage(tc.ctx, binding.ToMessage(&want), pm); err != nil {
+				t.Fatalf("WritePubSubMessage() = %v", err)
+			}
+			if len(pm.Attributes) != len(tc.wantAttributes) {
+				t.Errorf("Attributes = %v, want %v", pm.Attributes, tc.wantAttributes)
+			}
+			for k, v := range tc.wantAttributes {
+				if pm.Attributes[k] != v {
+					t.Errorf("Attributes[%q] = %q, want %q", k, pm.Attributes[k], v)
+				}
+			}
+
+			msg := NewMessage(pm)
+			if got := msg.ReadEncoding(); got != tc.wantEncoding {
+				t.Errorf("ReadEncoding() = %v, want %v", got, tc.wantEncoding)
+			}
+			got, err := binding.ToEvent(context.Background(), msg)
+			if err != nil {
+				t.Fatalf("ToEvent() = %v", err)
+			}
+			if got.String() != want.String() {
+				t.Errorf("ToEvent() = %v, want %v", got, want)
+			}
+		})
+	}
+}
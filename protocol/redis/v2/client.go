@@ -0,0 +1,33 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package redis
+
+import (
+	"context"
+	"time"
+)
+
+// StreamEntry is an entry of a Redis Stream.
+type StreamEntry struct {
+	// ID is the id of the entry, assigned by Redis.
+	ID string
+	// Values are the fields of the entry.
+	Values map[string]string
+}
+
+// StreamClient is the subset of the Redis Streams commands used by Sender and Receiver.
+type StreamClient interface {
+	// XAdd appends an entry with the given values to stream (XADD stream * field value ...),
+	// returning the id of the entry.
+	XAdd(ctx context.Context, stream string, values map[string]string) (string, error)
+	// XReadGroup reads up to count new entries of stream for the given consumer of group
+	// (XREADGROUP GROUP group consumer COUNT count BLOCK block STREAMS stream >),
+	// blocking up to block if no entry is available.
+	// It returns no entries and no error if block expires.
+	XReadGroup(ctx context.Context, stream, group, consumer string, count int64, block time.Duration) ([]StreamEntry, error)
+	// XAck acknowledges the entries with the given ids of stream for group (XACK stream group id ...).
+	XAck(ctx context.Context, stream, group string, ids ...string) error
+}
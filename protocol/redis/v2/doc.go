@@ -0,0 +1,16 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+/*
+Package redis implements a Redis Streams binding.
+
+Events are sent with XADD and received with XREADGROUP, through a StreamClient,
+which can be easily implemented on top of any Redis client library.
+In binary mode, each attribute and extension is stored in a stream field
+prefixed with ce_, the datacontenttype in the content-type field and the data
+in the data field. In structured mode, the content-type field holds the media
+type of the event format and the data field the encoded event.
+*/
+package redis
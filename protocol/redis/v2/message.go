@@ -0,0 +1,129 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package redis
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/binding/format"
+	"github.com/cloudevents/sdk-go/v2/binding/spec"
+)
+
+const (
+	prefix           = "ce_"
+	contentTypeField = "content-type"
+	dataField        = "data"
+)
+
+var specs = spec.WithPrefix(prefix)
+
+// acker acknowledges the entries of a stream.
+type acker interface {
+	ack(ctx context.Context, id string) error
+}
+
+// Message holds a Redis Stream entry.
+// This message *can* be read several times safely
+type Message struct {
+	Entry StreamEntry
+
+	format  format.Format
+	version spec.Version
+	acker   acker
+}
+
+// Check if redis.Message implements binding.Message
+var (
+	_ binding.Message               = (*Message)(nil)
+	_ binding.MessageMetadataReader = (*Message)(nil)
+)
+
+// NewMessage returns a binding.Message that holds the provided stream entry.
+// The returned binding.Message *can* be read several times safely
+func NewMessage(entry StreamEntry) *Message {
+	return newMessage(entry, nil)
+}
+
+func newMessage(entry StreamEntry, acker acker) *Message {
+	m := &Message{Entry: entry, acker: acker}
+	if ft := format.Lookup(entry.Values[contentTypeField]); ft != nil {
+		m.format = ft
+	} else if v := specs.Version(entry.Values[specs.PrefixedSpecVersionName()]); v != nil {
+		m.version = v
+	}
+	return m
+}
+
+func (m *Message) ReadEncoding() binding.Encoding {
+	if m.version != nil {
+		return binding.EncodingBinary
+	}
+	if m.format != nil {
+		return binding.EncodingStructured
+	}
+	return binding.EncodingUnknown
+}
+
+func (m *Message) ReadStructured(ctx context.Context, encoder binding.StructuredWriter) error {
+	if m.format != nil {
+		return encoder.SetStructuredEvent(ctx, m.format, strings.NewReader(m.Entry.Values[dataField]))
+	}
+	return binding.ErrNotStructured
+}
+
+func (m *Message) ReadBinary(ctx context.Context, encoder binding.BinaryWriter) (err error) {
+	if m.version == nil {
+		return binding.ErrNotBinary
+	}
+
+	for k, v := range m.Entry.Values {
+		if strings.HasPrefix(k, prefix) {
+			attr := m.version.Attribute(k)
+			if attr != nil {
+				err = encoder.SetAttribute(attr, v)
+			} else {
+				err = encoder.SetExtension(strings.TrimPrefix(k, prefix), v)
+			}
+		} else if k == contentTypeField {
+			err = encoder.SetAttribute(m.version.AttributeFromKind(spec.DataContentType), v)
+		}
+		if err != nil {
+			return
+		}
+	}
+
+	if data, ok := m.Entry.Values[dataField]; ok {
+		err = encoder.SetData(strings.NewReader(data))
+	}
+	return
+}
+
+func (m *Message) GetAttribute(k spec.Kind) (spec.Attribute, interface{}) {
+	attr := m.version.AttributeFromKind(k)
+	if attr != nil {
+		if k == spec.DataContentType {
+			return attr, m.Entry.Values[contentTypeField]
+		}
+		return attr, m.Entry.Values[attr.PrefixedName()]
+	}
+	return nil, nil
+}
+
+func (m *Message) GetExtension(name string) interface{} {
+	return m.Entry.Values[prefix+name]
+}
+
+// Finish acknowledges the entry with XACK if err is nil. Otherwise, the entry
+// is left in the pending entries list of the consumer group, so that it can be
+// claimed again (XCLAIM or XAUTOCLAIM) and redelivered.
+func (m *Message) Finish(err error) error {
+	if err != nil || m.acker == nil {
+		return nil
+	}
+	return m.acker.ack(context.Background(), m.Entry.ID)
+}
@@ -0,0 +1,27 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package redis
+
+import "time"
+
+// ReceiveOption is the type of redis receiver options
+type ReceiveOption func(*receiver)
+
+// WithReadCount sets the maximum number of entries read by each XREADGROUP.
+// Default is 10.
+func WithReadCount(count int64) ReceiveOption {
+	return func(r *receiver) {
+		r.readCount = count
+	}
+}
+
+// WithBlock sets how long each XREADGROUP blocks waiting for new entries,
+// before being issued again. Default is 5 seconds.
+func WithBlock(block time.Duration) ReceiveOption {
+	return func(r *receiver) {
+		r.block = block
+	}
+}
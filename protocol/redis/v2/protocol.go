@@ -0,0 +1,75 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package redis
+
+import (
+	"context"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+)
+
+type Protocol struct {
+	// Redis
+	Client StreamClient
+	Stream string
+
+	// Sender
+	Sender *sender
+
+	// Receiver
+	Receiver *receiver
+}
+
+// NewProtocol creates a new redis transport, sending to and receiving from stream,
+// as consumer of group.
+func NewProtocol(client StreamClient, stream, group, consumer string, opts ...ReceiveOption) *Protocol {
+	return &Protocol{
+		Client:   client,
+		Stream:   stream,
+		Sender:   NewSender(client, stream).(*sender),
+		Receiver: newReceiver(client, stream, group, consumer, opts...),
+	}
+}
+
+// NewSenderProtocol creates a new sender redis transport.
+func NewSenderProtocol(client StreamClient, stream string) *Protocol {
+	return &Protocol{
+		Client: client,
+		Stream: stream,
+		Sender: NewSender(client, stream).(*sender),
+	}
+}
+
+// NewReceiverProtocol creates a new receiver redis transport.
+func NewReceiverProtocol(client StreamClient, stream, group, consumer string, opts ...ReceiveOption) *Protocol {
+	return &Protocol{
+		Client:   client,
+		Stream:   stream,
+		Receiver: newReceiver(client, stream, group, consumer, opts...),
+	}
+}
+
+// Close stops the receiver, so that Receive returns io.EOF.
+// The client is not closed, as it is owned by the caller.
+func (t *Protocol) Close(ctx context.Context) error {
+	if t.Receiver != nil {
+		return t.Receiver.Close(ctx)
+	}
+	return nil
+}
+
+func (t *Protocol) Send(ctx context.Context, in binding.Message, transformers ...binding.Transformer) error {
+	return t.Sender.Send(ctx, in, transformers...)
+}
+
+func (t *Protocol) Receive(ctx context.Context) (binding.Message, error) {
+	return t.Receiver.Receive(ctx)
+}
+
+var _ protocol.Sender = (*Protocol)(nil)
+var _ protocol.Receiver = (*Protocol)(nil)
+var _ protocol.Closer = (*Protocol)(nil)
@@ -0,0 +1,169 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/test"
+)
+
+// fakeStreamClient is an in memory stream, with a single consumer group.
+type fakeStreamClient struct {
+	mu      sync.Mutex
+	entries []StreamEntry
+	next    int
+	pending map[string]bool
+	readErr error
+}
+
+func (c *fakeStreamClient) XAdd(_ context.Context, _ string, values map[string]string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	id := strconv.Itoa(len(c.entries)) + "-0"
+	c.entries = append(c.entries, StreamEntry{ID: id, Values: values})
+	return id, nil
+}
+
+func (c *fakeStreamClient) XReadGroup(ctx context.Context, _, _, _ string, count int64, _ time.Duration) ([]StreamEntry, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.readErr != nil {
+		return nil, c.readErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	var read []StreamEntry
+	for ; c.next < len(c.entries) && int64(len(read)) < count; c.next++ {
+		read = append(read, c.entries[c.next])
+		if c.pending == nil {
+			c.pending = make(map[string]bool)
+		}
+		c.pending[c.entries[c.next].ID] = true
+	}
+	return read, nil
+}
+
+func (c *fakeStreamClient) XAck(_ context.Context, _, _ string, ids ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, id := range ids {
+		delete(c.pending, id)
+	}
+	return nil
+}
+
+func TestSendReceive(t *testing.T) {
+	ctx := context.Background()
+	client := &fakeStreamClient{}
+	p := NewProtocol(client, "events", "group", "consumer")
+
+	binaryEvent := test.FullEvent()
+	structuredEvent := test.FullEvent()
+	structuredEvent.SetID("structured")
+
+	require.NoError(t, p.Send(ctx, binding.ToMessage(&binaryEvent)))
+	require.NoError(t, p.Send(binding.WithForceStructured(ctx), binding.ToMessage(&structuredEvent)))
+	require.Equal(t, binaryEvent.DataContentType(), client.entries[0].Values["content-type"])
+	require.Equal(t, event.ApplicationCloudEventsJSON, client.entries[1].Values["content-type"])
+
+	for _, tc := range []struct {
+		encoding binding.Encoding
+		want     event.Event
+	}{
+		{binding.EncodingBinary, test.ConvertEventExtensionsToString(t, binaryEvent)},
+		{binding.EncodingStructured, roundTrip(t, structuredEvent)},
+	} {
+		m, err := p.Receive(ctx)
+		require.NoError(t, err)
+		require.Equal(t, tc.encoding, m.ReadEncoding())
+		got, err := binding.ToEvent(ctx, m)
+		require.NoError(t, err)
+		test.AssertEventEquals(t, tc.want, *got)
+	}
+}
+
+// roundTrip returns e as decoded from its JSON encoding.
+func roundTrip(t *testing.T, e event.Event) event.Event {
+	b, err := json.Marshal(e)
+	require.NoError(t, err)
+	var got event.Event
+	require.NoError(t, json.Unmarshal(b, &got))
+	return got
+}
+
+func TestFinish(t *testing.T) {
+	ctx := context.Background()
+	client := &fakeStreamClient{}
+	p := NewProtocol(client, "events", "group", "consumer")
+	for i := 0; i < 2; i++ {
+		e := test.FullEvent()
+		e.SetID(fmt.Sprint(i))
+		require.NoError(t, p.Send(ctx, binding.ToMessage(&e)))
+	}
+
+	acked, err := p.Receive(ctx)
+	require.NoError(t, err)
+	failed, err := p.Receive(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, acked.Finish(nil))
+	require.NoError(t, failed.Finish(errors.New("failed")))
+	require.Equal(t, map[string]bool{"1-0": true}, client.pending)
+}
+
+func TestReceive_ConnectionLost(t *testing.T) {
+	client := &fakeStreamClient{readErr: fmt.Errorf("read: %w", io.ErrUnexpectedEOF)}
+	p := NewReceiverProtocol(client, "events", "group", "consumer")
+	_, err := p.Receive(context.Background())
+	require.Equal(t, io.EOF, err)
+
+	client.readErr = errors.New("NOGROUP No such key 'events' or consumer group 'group'")
+	_, err = p.Receive(context.Background())
+	require.Equal(t, client.readErr, err)
+}
+
+func TestReceive_Close(t *testing.T) {
+	p := NewReceiverProtocol(&fakeStreamClient{}, "events", "group", "consumer", WithBlock(time.Millisecond))
+	done := make(chan error)
+	go func() {
+		_, err := p.Receive(context.Background())
+		done <- err
+	}()
+	require.NoError(t, p.Close(context.Background()))
+	require.Equal(t, io.EOF, <-done)
+}
+
+func TestReceive_ReadCount(t *testing.T) {
+	ctx := context.Background()
+	client := &fakeStreamClient{}
+	for i := 0; i < 3; i++ {
+		_, _ = client.XAdd(ctx, "events", map[string]string{"ce_specversion": "1.0"})
+	}
+	p := NewReceiverProtocol(client, "events", "group", "consumer", WithReadCount(2))
+	_, err := p.Receive(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, client.next)
+	_, err = p.Receive(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, client.next)
+	_, err = p.Receive(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, client.next)
+}
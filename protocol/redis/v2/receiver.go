@@ -0,0 +1,106 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package redis
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+)
+
+const (
+	defaultReadCount = 10
+	defaultBlock     = 5 * time.Second
+)
+
+// receiver reads the messages of a Redis Stream with XREADGROUP
+type receiver struct {
+	client   StreamClient
+	stream   string
+	group    string
+	consumer string
+
+	readCount int64
+	block     time.Duration
+
+	mu      sync.Mutex
+	entries []StreamEntry
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+// Receive returns the next entry of the stream for the consumer group.
+// The entries are read in batches, see WithReadCount, and the returned
+// messages must be finished to be acknowledged.
+// If the connection to Redis is lost, Receive returns io.EOF.
+func (r *receiver) Receive(ctx context.Context) (binding.Message, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for len(r.entries) == 0 {
+		select {
+		case <-r.closed:
+			return nil, io.EOF
+		default:
+		}
+
+		entries, err := r.client.XReadGroup(ctx, r.stream, r.group, r.consumer, r.readCount, r.block)
+		if err != nil {
+			if ctx.Err() != nil || isConnectionLost(err) {
+				return nil, io.EOF
+			}
+			return nil, err
+		}
+		r.entries = entries
+	}
+
+	entry := r.entries[0]
+	r.entries = r.entries[1:]
+	return newMessage(entry, r), nil
+}
+
+func (r *receiver) ack(ctx context.Context, id string) error {
+	return r.client.XAck(ctx, r.stream, r.group, id)
+}
+
+func (r *receiver) Close(context.Context) error {
+	r.closeOnce.Do(func() { close(r.closed) })
+	return nil
+}
+
+// isConnectionLost reports whether err is caused by the loss of the connection to Redis.
+func isConnectionLost(err error) bool {
+	var opErr *net.OpError
+	return errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, net.ErrClosed) || errors.As(err, &opErr)
+}
+
+// NewReceiver creates a new Receiver which reads the messages of stream with
+// XREADGROUP, as consumer of group. The consumer group must exist.
+func NewReceiver(client StreamClient, stream, group, consumer string, opts ...ReceiveOption) protocol.Receiver {
+	return newReceiver(client, stream, group, consumer, opts...)
+}
+
+func newReceiver(client StreamClient, stream, group, consumer string, opts ...ReceiveOption) *receiver {
+	r := &receiver{
+		client:    client,
+		stream:    stream,
+		group:     group,
+		consumer:  consumer,
+		readCount: defaultReadCount,
+		block:     defaultBlock,
+		closed:    make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
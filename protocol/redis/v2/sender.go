@@ -0,0 +1,39 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package redis
+
+import (
+	"context"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+)
+
+// sender appends the messages to a Redis Stream with XADD
+type sender struct {
+	client StreamClient
+	stream string
+}
+
+func (s *sender) Send(ctx context.Context, in binding.Message, transformers ...binding.Transformer) error {
+	var err error
+	defer func() { _ = in.Finish(err) }()
+	values := make(map[string]string)
+	if m, ok := in.(*Message); ok { // Already a stream entry.
+		for k, v := range m.Entry.Values {
+			values[k] = v
+		}
+	} else if err = WriteValues(ctx, in, values, transformers...); err != nil {
+		return err
+	}
+	_, err = s.client.XAdd(ctx, s.stream, values)
+	return err
+}
+
+// NewSender creates a new Sender which appends the messages to stream with XADD
+func NewSender(client StreamClient, stream string) protocol.Sender {
+	return &sender{client: client, stream: stream}
+}
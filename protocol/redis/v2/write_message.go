@@ -0,0 +1,84 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package redis
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/binding/format"
+	"github.com/cloudevents/sdk-go/v2/binding/spec"
+	"github.com/cloudevents/sdk-go/v2/types"
+)
+
+// WriteValues fills the provided values of a stream entry with the message m.
+// Using context you can tweak the encoding processing (more details on binding.Write documentation).
+func WriteValues(ctx context.Context, m binding.Message, values map[string]string, transformers ...binding.Transformer) error {
+	writer := valuesWriter(values)
+	_, err := binding.Write(
+		ctx,
+		m,
+		writer,
+		writer,
+		transformers...,
+	)
+	return err
+}
+
+type valuesWriter map[string]string
+
+func (w valuesWriter) SetStructuredEvent(ctx context.Context, format format.Format, event io.Reader) error {
+	w[contentTypeField] = format.MediaType()
+	return w.SetData(event)
+}
+
+func (w valuesWriter) Start(ctx context.Context) error {
+	return nil
+}
+
+func (w valuesWriter) End(ctx context.Context) error {
+	return nil
+}
+
+func (w valuesWriter) SetData(reader io.Reader) error {
+	var sb strings.Builder
+	if _, err := io.Copy(&sb, reader); err != nil {
+		return err
+	}
+	w[dataField] = sb.String()
+	return nil
+}
+
+func (w valuesWriter) SetAttribute(attribute spec.Attribute, value interface{}) error {
+	name := prefix + attribute.Name()
+	if attribute.Kind() == spec.DataContentType {
+		name = contentTypeField
+	}
+	return w.set(name, value)
+}
+
+func (w valuesWriter) SetExtension(name string, value interface{}) error {
+	return w.set(prefix+name, value)
+}
+
+func (w valuesWriter) set(name string, value interface{}) error {
+	if value == nil {
+		delete(w, name)
+		return nil
+	}
+	// Stream fields, everything is a string!
+	s, err := types.Format(value)
+	if err != nil {
+		return err
+	}
+	w[name] = s
+	return nil
+}
+
+var _ binding.StructuredWriter = (valuesWriter)(nil) // Test it conforms to the interface
+var _ binding.BinaryWriter = (valuesWriter)(nil)     // Test it conforms to the interface
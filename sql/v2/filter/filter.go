@@ -0,0 +1,42 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package filter implements client event filters based on CloudEvents SQL expressions.
+package filter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudevents/sdk-go/sql/v2/parser"
+	"github.com/cloudevents/sdk-go/v2/client"
+	cecontext "github.com/cloudevents/sdk-go/v2/context"
+	"github.com/cloudevents/sdk-go/v2/event"
+)
+
+// Expression compiles the CloudEvents SQL expression expr, e.g.
+// "type LIKE 'com.example.%' AND EXISTS priority AND priority > 3", into an
+// event filter accepting the events for which expr evaluates to true. The
+// expression can refer to the attributes and extensions of the event.
+//
+// An error is returned if expr can't be compiled. At runtime, the events for
+// which expr doesn't evaluate to a boolean, or fails to evaluate, are rejected.
+//
+// The filter can be used with client.WithEventFilter.
+func Expression(expr string) (client.EventFilter, error) {
+	expression, err := parser.Parse(expr)
+	if err != nil {
+		return nil, fmt.Errorf("cannot compile the filter expression %q: %w", expr, err)
+	}
+	return func(ctx context.Context, e event.Event) bool {
+		res, err := expression.Evaluate(e)
+		if err != nil {
+			cecontext.LoggerFrom(ctx).Debugf("filter expression %q failed to evaluate on event %s: %v", expr, e.ID(), err)
+			return false
+		}
+		accepted, ok := res.(bool)
+		return ok && accepted
+	}, nil
+}
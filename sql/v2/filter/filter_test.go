@@ -0,0 +1,77 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package filter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+)
+
+func TestExpression(t *testing.T) {
+	newEvent := func(eventType string, priority interface{}) event.Event {
+		e := event.New()
+		e.SetID("id")
+		e.SetSource("/unit/test")
+		e.SetType(eventType)
+		if priority != nil {
+			e.SetExtension("priority", priority)
+		}
+		return e
+	}
+
+	testCases := map[string]struct {
+		expr  string
+		event event.Event
+		want  bool
+	}{
+		"type matches": {
+			expr:  "type LIKE 'com.example.%'",
+			event: newEvent("com.example.created", nil),
+			want:  true,
+		},
+		"type doesn't match": {
+			expr:  "type LIKE 'com.example.%'",
+			event: newEvent("org.other.created", nil),
+		},
+		"extension matches": {
+			expr:  "EXISTS priority AND priority > 3",
+			event: newEvent("com.example.created", 5),
+			want:  true,
+		},
+		"extension doesn't match": {
+			expr:  "EXISTS priority AND priority > 3",
+			event: newEvent("com.example.created", 2),
+		},
+		"missing extension": {
+			expr:  "EXISTS priority AND priority > 3",
+			event: newEvent("com.example.created", nil),
+		},
+		"not a boolean": {
+			expr:  "type",
+			event: newEvent("com.example.created", nil),
+		},
+		"evaluation error": {
+			expr:  "priority / 0 = 1",
+			event: newEvent("com.example.created", 5),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			filter, err := Expression(tc.expr)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, filter(context.Background(), tc.event))
+		})
+	}
+}
+
+func TestExpression_CompileError(t *testing.T) {
+	_, err := Expression("ABC(")
+	require.ErrorContains(t, err, `cannot compile the filter expression "ABC("`)
+}
@@ -64,7 +64,7 @@ func init() {
 		}),
 	)
 	transformers = append(transformers,
-		transformer.SetExtension("aTime", func(i2 interface{}) (interface{}, error) {
+		transformer.SetExtension("atime", func(i2 interface{}) (interface{}, error) {
 			if types.IsZero(i2) {
 				return time.Now(), nil
 			}
@@ -173,13 +173,13 @@ func transformEvent(e *event.Event) {
 	} else {
 		e.SetExtension("aaa", strings.ToUpper("AAA"))
 	}
-	if v, ok := e.Extensions()["aTime"]; ok {
+	if v, ok := e.Extensions()["atime"]; ok {
 		vTime, err := types.ToTime(v)
 		if err != nil {
 			panic(err)
 		}
-		e.SetExtension("aTime", vTime.Add(3*time.Hour))
+		e.SetExtension("atime", vTime.Add(3*time.Hour))
 	} else {
-		e.SetExtension("aTime", time.Now().UTC().Round(0))
+		e.SetExtension("atime", time.Now().UTC().Round(0))
 	}
 }
@@ -47,14 +47,14 @@ func BenchmarkToEventAndUpdateExtensions(b *testing.B) {
 		} else {
 			E.SetExtension("aaa", strings.ToUpper("AAA"))
 		}
-		if v, ok := E.Extensions()["aTime"]; ok {
+		if v, ok := E.Extensions()["atime"]; ok {
 			vTime, err := types.ToTime(v)
 			if err != nil {
 				panic(err)
 			}
-			E.SetExtension("aTime", vTime.Add(3*time.Hour))
+			E.SetExtension("atime", vTime.Add(3*time.Hour))
 		} else {
-			E.SetExtension("aTime", time.Now().UTC().Round(0))
+			E.SetExtension("atime", time.Now().UTC().Round(0))
 		}
 
 	}
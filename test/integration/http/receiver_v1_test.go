@@ -83,7 +83,7 @@ func TestClientReceiver_Status_Codes(t *testing.T) {
 				}
 			},
 		},
-		"200 if the receiver is not expecting an event and the received request doesn't contain an event": {
+		"204 if the receiver is not expecting an event and the received request doesn't contain an event": {
 			now: now,
 			request: func(url string) *http.Request {
 				req, _ := http.NewRequest("POST", url, bytes.NewReader(toBytes(map[string]interface{}{"hello": "Francesco"})))
@@ -92,13 +92,13 @@ func TestClientReceiver_Status_Codes(t *testing.T) {
 			},
 			asRecv: &TapValidation{
 				Header:        map[string][]string{},
-				Status:        fmt.Sprintf("%d %s", http.StatusOK, http.StatusText(http.StatusOK)),
+				Status:        fmt.Sprintf("%d %s", http.StatusNoContent, http.StatusText(http.StatusNoContent)),
 				ContentLength: 0,
 			},
 			receiverFuncFactory: func(cancelFunc context.CancelFunc) interface{} {
 				return func() *cloudevents.Event {
 					defer cancelFunc()
-					return nil // acts as a 200 OK
+					return nil // acts as a 204 No Content
 				}
 			},
 		},
@@ -18,6 +18,7 @@ type eventFormatKey int
 
 const (
 	formatEventStructured eventFormatKey = iota
+	dataKeyStructured
 )
 
 // EventMessage type-converts a event.Event object to implement Message.
@@ -39,7 +40,7 @@ func (m *EventMessage) ReadEncoding() Encoding {
 }
 
 func (m *EventMessage) ReadStructured(ctx context.Context, builder StructuredWriter) error {
-	f := GetOrDefaultFromCtx(ctx, formatEventStructured, format.JSON).(format.Format)
+	f := formatWithDataKey(ctx, GetOrDefaultFromCtx(ctx, formatEventStructured, format.JSON).(format.Format))
 	b, err := f.Marshal((*event.Event)(m))
 	if err != nil {
 		return err
@@ -108,3 +109,25 @@ var _ MessageMetadataReader = (*EventMessage)(nil) // Test it conforms to the in
 func UseFormatForEvent(ctx context.Context, f format.Format) context.Context {
 	return context.WithValue(ctx, formatEventStructured, f)
 }
+
+// WithDataKey makes the structured encoding and decoding of the JSON format,
+// i.e. "application/cloudevents+json", write and read the event data under
+// dataKey rather than under "data", see format.JSONWithDataKey. It applies to
+// the events written in structured mode with ctx and to the structured
+// messages converted with ToEvent(ctx, ...): to enable it for the events
+// received by a client, set it on the context passed to client.StartReceiver.
+//
+// The produced events are NOT compliant with the CloudEvents JSON format: this
+// is intended only for the interoperability with legacy systems.
+func WithDataKey(ctx context.Context, dataKey string) context.Context {
+	return context.WithValue(ctx, dataKeyStructured, dataKey)
+}
+
+// formatWithDataKey returns the JSON format using the data key set WithDataKey
+// in place of f, if f is the built-in JSON format.
+func formatWithDataKey(ctx context.Context, f format.Format) format.Format {
+	if dataKey, ok := ctx.Value(dataKeyStructured).(string); ok && f == format.JSON {
+		return format.JSONWithDataKey(dataKey)
+	}
+	return f
+}
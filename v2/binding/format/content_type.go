@@ -0,0 +1,48 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package format
+
+import (
+	"fmt"
+	"mime"
+	"strings"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+)
+
+// WithStructuredContentType returns the JSON format with contentType as media type,
+// e.g. "application/cloudevents+json; profile=acme", which is used as the
+// Content-Type of the messages in structured mode. To use it:
+//
+//	f, err := format.WithStructuredContentType("application/cloudevents+json; profile=acme")
+//	...
+//	ctx = binding.UseFormatForEvent(binding.WithForceStructured(ctx), f)
+//
+// contentType must be a "application/cloudevents" media type with the "+json" suffix,
+// so that the receivers still decode the messages with the JSON format, see Lookup.
+func WithStructuredContentType(contentType string) (Format, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, fmt.Errorf("invalid structured content type %q: %w", contentType, err)
+	}
+	if !isJSONFormat(mediaType) {
+		return nil, fmt.Errorf("invalid structured content type %q: expected an %s media type with the +json suffix", contentType, Prefix)
+	}
+	return jsonContentTypeFmt{jsonFmt: JSON, contentType: contentType}, nil
+}
+
+type jsonContentTypeFmt struct {
+	jsonFmt
+	contentType string
+}
+
+func (f jsonContentTypeFmt) MediaType() string { return f.contentType }
+
+// isJSONFormat returns true for the "application/cloudevents" media types with
+// the "+json" suffix, e.g. "application/cloudevents.acme+json", but the batch one.
+func isJSONFormat(mediaType string) bool {
+	return IsFormat(mediaType) && strings.HasSuffix(mediaType, "+json") && mediaType != event.ApplicationCloudEventsBatchJSON
+}
@@ -0,0 +1,69 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package format
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+)
+
+// JSONWithDataKey returns an "application/cloudevents+json" format which writes
+// and reads the event data under dataKey rather than under "data".
+// The base64 encoded data, i.e. "data_base64", is not renamed.
+//
+// The produced events are NOT compliant with the CloudEvents JSON format: this
+// format is intended only for the interoperability with legacy systems. Don't
+// replace the built-in JSON format with Add, as that would affect all the
+// senders and receivers: use binding.WithDataKey to scope it to a context.
+func JSONWithDataKey(dataKey string) Format {
+	return jsonDataKeyFmt{dataKey: dataKey}
+}
+
+type jsonDataKeyFmt struct {
+	dataKey string
+}
+
+func (jsonDataKeyFmt) MediaType() string { return event.ApplicationCloudEventsJSON }
+
+func (f jsonDataKeyFmt) Marshal(e *event.Event) ([]byte, error) {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	return renameKey(b, "data", f.dataKey)
+}
+
+func (f jsonDataKeyFmt) Unmarshal(b []byte, e *event.Event) error {
+	b, err := renameKey(bytes.TrimPrefix(b, utf8BOM), f.dataKey, "data")
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, e)
+}
+
+// renameKey renames the from key of the JSON object b to the to key.
+func renameKey(b []byte, from, to string) ([]byte, error) {
+	if from == to {
+		return b, nil
+	}
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil, err
+	}
+	v, ok := obj[from]
+	if !ok {
+		return b, nil
+	}
+	if _, ok := obj[to]; ok {
+		return nil, fmt.Errorf("cannot rename %q to %q: the event already has a %q key", from, to, to)
+	}
+	delete(obj, from)
+	obj[to] = v
+	return json.Marshal(obj)
+}
@@ -0,0 +1,62 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package format_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/binding/format"
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/types"
+)
+
+func TestJSONWithDataKey(t *testing.T) {
+	require := require.New(t)
+	f := format.JSONWithDataKey("payload")
+	require.Equal(event.ApplicationCloudEventsJSON, f.MediaType())
+
+	e := event.Event{
+		Context: event.EventContextV1{
+			Type:   "type",
+			ID:     "id",
+			Source: *types.ParseURIRef("source"),
+		}.AsV1(),
+	}
+	require.NoError(e.SetData(event.ApplicationJSON, map[string]string{"hello": "world"}))
+	b, err := f.Marshal(&e)
+	require.NoError(err)
+	assertJsonEquals(t, map[string]interface{}{
+		"payload":         map[string]interface{}{"hello": "world"},
+		"datacontenttype": "application/json",
+		"id":              "id",
+		"source":          "source",
+		"specversion":     "1.0",
+		"type":            "type",
+	}, b)
+
+	var e2 event.Event
+	require.NoError(f.Unmarshal(b, &e2))
+	require.Equal(e, e2)
+
+	// The standard format doesn't find the data
+	var e3 event.Event
+	require.Error(format.JSON.Unmarshal(b, &e3))
+}
+
+func TestJSONWithDataKey_Conflict(t *testing.T) {
+	f := format.JSONWithDataKey("payload")
+	e := event.New()
+	e.SetID("id")
+	e.SetType("type")
+	e.SetSource("source")
+	e.SetExtension("payload", "ext")
+	require.NoError(t, e.SetData(event.TextPlain, "data"))
+
+	_, err := f.Marshal(&e)
+	require.Error(t, err)
+}
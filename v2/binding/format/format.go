@@ -6,6 +6,7 @@
 package format
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -37,9 +38,15 @@ type jsonFmt struct{}
 
 func (jsonFmt) MediaType() string { return event.ApplicationCloudEventsJSON }
 
+// utf8BOM is the UTF-8 byte order mark, which some producers prepend to the JSON payload.
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
 func (jsonFmt) Marshal(e *event.Event) ([]byte, error) { return json.Marshal(e) }
+
+// Unmarshal strips the leading UTF-8 byte order mark, if any, before parsing b,
+// because encoding/json rejects it.
 func (jsonFmt) Unmarshal(b []byte, e *event.Event) error {
-	return json.Unmarshal(b, e)
+	return json.Unmarshal(bytes.TrimPrefix(b, utf8BOM), e)
 }
 
 // JSONBatch is the built-in "application/cloudevents-batch+json" format.
@@ -72,13 +79,22 @@ func init() {
 }
 
 // Lookup returns the format for contentType, or nil if not found.
+// The parameters of contentType are ignored and the unknown "application/cloudevents"
+// media types with the "+json" suffix, e.g. "application/cloudevents.acme+json",
+// are looked up as "application/cloudevents+json".
 func Lookup(contentType string) Format {
 	i := strings.IndexRune(contentType, ';')
 	if i == -1 {
 		i = len(contentType)
 	}
 	contentType = strings.TrimSpace(strings.ToLower(contentType[0:i]))
-	return formats[contentType]
+	if f, ok := formats[contentType]; ok {
+		return f
+	}
+	if isJSONFormat(contentType) {
+		return formats[event.ApplicationCloudEventsJSON]
+	}
+	return nil
 }
 
 func unknown(mediaType string) error {
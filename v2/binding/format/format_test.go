@@ -44,6 +44,18 @@ func TestJSON(t *testing.T) {
 	require.Equal(e, e2)
 }
 
+func TestJSONUnmarshalWithBOM(t *testing.T) {
+	require := require.New(t)
+	b := append([]byte("\xEF\xBB\xBF"), []byte(`{"specversion":"1.0","id":"id","source":"source","type":"type"}`)...)
+
+	var e event.Event
+	require.NoError(format.JSON.Unmarshal(b, &e))
+	require.Equal("1.0", e.SpecVersion())
+	require.Equal("id", e.ID())
+	require.Equal("source", e.Source())
+	require.Equal("type", e.Type())
+}
+
 func TestLookup(t *testing.T) {
 	require := require.New(t)
 	require.Nil(format.Lookup("nosuch"))
@@ -133,3 +145,35 @@ func assertJsonEquals(t *testing.T, want map[string]interface{}, got []byte) {
 
 	require.Equal(t, wantToCompare, gotToCompare)
 }
+
+func TestWithStructuredContentType(t *testing.T) {
+	require := require.New(t)
+	const contentType = "application/cloudevents+json; profile=acme"
+	f, err := format.WithStructuredContentType(contentType)
+	require.NoError(err)
+	require.Equal(contentType, f.MediaType())
+
+	e := event.New()
+	e.SetID("id")
+	e.SetSource("source")
+	e.SetType("type")
+	require.NoError(e.SetData(event.ApplicationJSON, "foo"))
+	b, err := f.Marshal(&e)
+	require.NoError(err)
+
+	// the receiver finds the JSON format from the parameterized content type
+	decoder := format.Lookup(f.MediaType())
+	require.Equal(format.JSON, decoder)
+	var e2 event.Event
+	require.NoError(decoder.Unmarshal(b, &e2))
+	require.Equal(e, e2)
+
+	require.Equal(format.JSON, format.Lookup("application/cloudevents.acme+json"))
+	require.Equal(format.JSONBatch, format.Lookup(event.ApplicationCloudEventsBatchJSON))
+	require.Nil(format.Lookup("application/cloudevents+xml"))
+
+	_, err = format.WithStructuredContentType("application/json; profile=acme")
+	require.EqualError(err, `invalid structured content type "application/json; profile=acme": expected an application/cloudevents media type with the +json suffix`)
+	_, err = format.WithStructuredContentType("application/cloudevents+json; profile")
+	require.Error(err)
+}
@@ -8,10 +8,15 @@ package binding
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/cloudevents/sdk-go/v2/binding/format"
 	"github.com/cloudevents/sdk-go/v2/binding/spec"
@@ -53,8 +58,12 @@ func ToEvent(ctx context.Context, message MessageReader, transformers ...Transfo
 
 	e := event.New()
 	encoder := (*messageToEventBuilder)(&e)
+	writeCtx := context.Background()
+	if dataKey, ok := ctx.Value(dataKeyStructured).(string); ok {
+		writeCtx = WithDataKey(writeCtx, dataKey)
+	}
 	_, err := DirectWrite(
-		context.Background(),
+		writeCtx,
 		message,
 		encoder,
 		encoder,
@@ -62,7 +71,196 @@ func ToEvent(ctx context.Context, message MessageReader, transformers ...Transfo
 	if err != nil {
 		return nil, err
 	}
-	return &e, Transformers(transformers).Transform((*EventMessage)(&e), encoder)
+	if messageEncoding == EncodingBinary {
+		if err := decodeDataContentEncoding(&e); err != nil {
+			return nil, err
+		}
+	}
+	defaultDataContentType(&e)
+	if GetOrDefaultFromCtx(ctx, unknownTypeInference, false).(bool) {
+		inferExtensionTypes(&e)
+	}
+	if messageEncoding == EncodingStructured && GetOrDefaultFromCtx(ctx, base64DataField, false).(bool) {
+		if err := decodeBase64DataField(&e); err != nil {
+			return &e, err
+		}
+	}
+	if err := Transformers(transformers).Transform((*EventMessage)(&e), encoder); err != nil {
+		return &e, err
+	}
+	if GetOrDefaultFromCtx(ctx, utf8Validation, false).(bool) {
+		return &e, validateUTF8(&e)
+	}
+	return &e, nil
+}
+
+type toEventKey int
+
+const (
+	utf8Validation toEventKey = iota
+	base64DataField
+	unknownTypeInference
+)
+
+// WithUTF8Validation enables, when converting a Message to an Event with ToEvent,
+// the validation of the attributes and extension values as UTF-8 strings.
+// If some of them are not valid UTF-8, ToEvent returns an event.ValidationError
+// keyed by the offending attribute names.
+// To enable it for the events received by a client, set it on the context
+// passed to client.StartReceiver.
+func WithUTF8Validation(ctx context.Context) context.Context {
+	return context.WithValue(ctx, utf8Validation, true)
+}
+
+// WithBase64DataField makes ToEvent decode as base64 the "data" member of the
+// structured events whose datacontenttype is not textual, i.e. neither text/*
+// nor a JSON or XML media type, as written by the non-conformant producers which
+// don't use "data_base64" for binary data. Without it, the "data" string is kept
+// as is, as required by the JSON event format.
+func WithBase64DataField(ctx context.Context) context.Context {
+	return context.WithValue(ctx, base64DataField, true)
+}
+
+// WithUnknownTypeInference makes ToEvent infer the type of the string values of
+// the extensions, i.e. the attributes unknown to the spec version, which are
+// decoded as strings from the binary messages and, unless they are JSON booleans
+// or numbers, from the structured ones. The first type the value parses as is used,
+// in this order:
+//
+//  1. boolean: "true" or "false"
+//  2. integer: a number within the int32 range
+//  3. float: a number with a fraction, which is kept as a string, as CloudEvents has no float type
+//  4. timestamp: an RFC 3339 time, stored as types.Timestamp
+//  5. URI: an absolute URI, stored as types.URI
+//  6. string
+//
+// When the event is written back, the values are formatted as the same strings
+// in binary mode, while booleans and integers become JSON booleans and numbers
+// in structured mode.
+func WithUnknownTypeInference(ctx context.Context) context.Context {
+	return context.WithValue(ctx, unknownTypeInference, true)
+}
+
+func inferExtensionTypes(e *event.Event) {
+	if e.Context == nil {
+		return
+	}
+	for name, v := range e.Extensions() {
+		if s, ok := v.(string); ok {
+			if inferred := inferType(s); inferred != nil {
+				_ = e.Context.SetExtension(name, inferred)
+			}
+		}
+	}
+}
+
+// inferType returns the value s parses as, or nil if s is a plain string.
+func inferType(s string) interface{} {
+	switch s {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	if i, err := strconv.ParseInt(s, 10, 32); err == nil && strconv.FormatInt(i, 10) == s {
+		return int32(i)
+	}
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return nil
+	}
+	if t, err := types.ParseTime(s); err == nil {
+		return types.Timestamp{Time: t}
+	}
+	if u, err := url.Parse(s); err == nil && u.IsAbs() && !strings.ContainsAny(s, " \t\n") {
+		return types.URI{URL: *u}
+	}
+	return nil
+}
+
+func decodeBase64DataField(e *event.Event) error {
+	if e.DataBase64 || e.DataEncoded == nil || isTextMediaType(e.DataMediaType()) {
+		return nil
+	}
+	data := make([]byte, base64.StdEncoding.DecodedLen(len(e.DataEncoded)))
+	n, err := base64.StdEncoding.Decode(data, e.DataEncoded)
+	if err != nil {
+		return fmt.Errorf("cannot decode the data field as base64: %w", err)
+	}
+	e.DataEncoded = data[:n]
+	e.DataBase64 = true
+	return nil
+}
+
+// decodeDataContentEncoding handles the 0.3 datacontentencoding attribute of
+// the binary messages. With datacontentencoding base64, the body is decoded.
+// A body of a non textual media type is marked as base64 data, without adding
+// the attribute, so it's encoded only when the event is written as structured.
+// In both cases, the event holds the raw data: writing it as binary doesn't encode it again.
+func decodeDataContentEncoding(e *event.Event) error {
+	ec, ok := e.Context.(*event.EventContextV03)
+	if !ok || e.DataEncoded == nil {
+		return nil
+	}
+	if ec.DataContentEncoding == nil {
+		if !isTextMediaType(e.DataMediaType()) {
+			e.DataBase64 = true
+		}
+		return nil
+	}
+	if *ec.DataContentEncoding != event.Base64 {
+		return event.ValidationError{"datacontentencoding": errors.New("invalid datacontentencoding value, the only allowed value is 'base64'")}
+	}
+	data := make([]byte, base64.StdEncoding.DecodedLen(len(e.DataEncoded)))
+	n, err := base64.StdEncoding.Decode(data, e.DataEncoded)
+	if err != nil {
+		return fmt.Errorf("cannot decode the data as base64: %w", err)
+	}
+	e.DataEncoded = data[:n]
+	e.DataBase64 = true
+	return nil
+}
+
+// defaultDataContentType sets the datacontenttype of the events without one,
+// whose data is JSON, to application/json, as implied by the JSON event format.
+// This is done for both the structured and the binary messages, so the
+// datacontenttype of the event doesn't depend on the encoding of the message.
+func defaultDataContentType(e *event.Event) {
+	if e.Context == nil || e.DataContentType() != "" || e.DataEncoded == nil || e.DataBase64 {
+		return
+	}
+	if json.Valid(e.DataEncoded) {
+		e.SetDataContentType(event.ApplicationJSON)
+	}
+}
+
+func isTextMediaType(mediaType string) bool {
+	return mediaType == "" || strings.HasPrefix(mediaType, "text/") ||
+		mediaType == event.ApplicationJSON || strings.HasSuffix(mediaType, "+json") ||
+		mediaType == event.ApplicationXML || strings.HasSuffix(mediaType, "+xml")
+}
+
+var errInvalidUTF8 = errors.New("not a valid UTF-8 string")
+
+func validateUTF8(e *event.Event) error {
+	errs := event.ValidationError{}
+	if version := spec.VS.Version(e.SpecVersion()); version != nil {
+		for _, attr := range version.Attributes() {
+			if v := attr.Get(e.Context); v != nil {
+				if s, err := types.Format(v); err == nil && !utf8.ValidString(s) {
+					errs[attr.Name()] = errInvalidUTF8
+				}
+			}
+		}
+	}
+	for name, v := range e.Extensions() {
+		if s, err := types.Format(v); err == nil && !utf8.ValidString(s) {
+			errs[name] = errInvalidUTF8
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
 }
 
 // ToEvents translates a Batch Message and corresponding Reader data to a slice of Events.
@@ -91,7 +289,7 @@ func (b *messageToEventBuilder) SetStructuredEvent(ctx context.Context, format f
 	if err != nil {
 		return err
 	}
-	return format.Unmarshal(buf.Bytes(), (*event.Event)(b))
+	return formatWithDataKey(ctx, format).Unmarshal(buf.Bytes(), (*event.Event)(b))
 }
 
 func (b *messageToEventBuilder) Start(ctx context.Context) error {
@@ -142,6 +340,14 @@ func (b *messageToEventBuilder) SetAttribute(attribute spec.Attribute, value int
 }
 
 func (b *messageToEventBuilder) SetExtension(name string, value interface{}) error {
+	if strings.EqualFold(name, event.DataContentEncodingKey) && b.Context.GetSpecVersion() == event.CloudEventsVersionV03 {
+		// datacontentencoding is a 0.3 attribute, though not described by the binding spec
+		str, err := types.ToString(value)
+		if value != nil && err != nil {
+			return err
+		}
+		return b.Context.DeprecatedSetDataContentEncoding(str)
+	}
 	if value == nil {
 		return b.Context.SetExtension(name, nil)
 	}
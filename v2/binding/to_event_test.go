@@ -7,19 +7,26 @@ package binding_test
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
 	"io"
 	nethttp "net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/cloudevents/sdk-go/v2/protocol/http"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/binding/format"
 	"github.com/cloudevents/sdk-go/v2/binding/spec"
 	. "github.com/cloudevents/sdk-go/v2/binding/test"
 	"github.com/cloudevents/sdk-go/v2/event"
 	. "github.com/cloudevents/sdk-go/v2/test"
+	"github.com/cloudevents/sdk-go/v2/types"
 )
 
 type toEventTestCase struct {
@@ -209,3 +216,202 @@ func TestToEvents(t *testing.T) {
 		})
 	}
 }
+
+func TestToEvent_UTF8Validation(t *testing.T) {
+	newMessage := func(subject string, ext string) binding.Message {
+		header := nethttp.Header{}
+		header.Set("ce-specversion", "1.0")
+		header.Set("ce-id", "123")
+		header.Set("ce-type", "unit.test")
+		header.Set("ce-source", "/unit/test")
+		header.Set("ce-subject", subject)
+		header.Set("ce-exstring", ext)
+		return http.NewMessage(header, nil)
+	}
+
+	// Lenient by default
+	e, err := binding.ToEvent(context.Background(), newMessage("sub\xffject", "valid"))
+	require.NoError(t, err)
+	require.Equal(t, "sub\xffject", e.Subject())
+
+	ctx := binding.WithUTF8Validation(context.Background())
+
+	_, err = binding.ToEvent(ctx, newMessage("subject", "valid"))
+	require.NoError(t, err)
+
+	_, err = binding.ToEvent(ctx, newMessage("sub\xffject", "in\xc3\x28valid"))
+	require.Error(t, err)
+	var verr event.ValidationError
+	require.ErrorAs(t, err, &verr)
+	require.Contains(t, verr, "subject")
+	require.Contains(t, verr, "exstring")
+	require.Len(t, verr, 2)
+}
+
+func TestToEvent_Base64DataField(t *testing.T) {
+	newMessage := func(contentType, data string) binding.Message {
+		header := nethttp.Header{}
+		header.Set("Content-Type", event.ApplicationCloudEventsJSON)
+		body := fmt.Sprintf(`{"specversion":"1.0","id":"123","type":"unit.test","source":"/unit/test","datacontenttype":%q,"data":%q}`, contentType, data)
+		return http.NewMessage(header, io.NopCloser(strings.NewReader(body)))
+	}
+	payload := []byte{0x00, 0x01, 0xfe, 0xff}
+	encoded := base64.StdEncoding.EncodeToString(payload)
+
+	// Strict by default
+	e, err := binding.ToEvent(context.Background(), newMessage("application/octet-stream", encoded))
+	require.NoError(t, err)
+	require.Equal(t, []byte(encoded), e.Data())
+
+	ctx := binding.WithBase64DataField(context.Background())
+
+	e, err = binding.ToEvent(ctx, newMessage("application/octet-stream", encoded))
+	require.NoError(t, err)
+	require.Equal(t, payload, e.Data())
+	require.True(t, e.DataBase64)
+
+	e, err = binding.ToEvent(ctx, newMessage("text/plain", encoded))
+	require.NoError(t, err)
+	require.Equal(t, []byte(encoded), e.Data())
+
+	_, err = binding.ToEvent(ctx, newMessage("application/octet-stream", "not base64!"))
+	require.Error(t, err)
+}
+
+func TestToEvent_DefaultDataContentType(t *testing.T) {
+	structured := func(data string) binding.Message {
+		header := nethttp.Header{}
+		header.Set("Content-Type", event.ApplicationCloudEventsJSON)
+		body := `{"specversion":"1.0","id":"123","type":"unit.test","source":"/unit/test","data":` + data + `}`
+		return http.NewMessage(header, io.NopCloser(strings.NewReader(body)))
+	}
+	binary := func(data string) binding.Message {
+		header := nethttp.Header{}
+		header.Set("ce-specversion", "1.0")
+		header.Set("ce-id", "123")
+		header.Set("ce-type", "unit.test")
+		header.Set("ce-source", "/unit/test")
+		return http.NewMessage(header, io.NopCloser(strings.NewReader(data)))
+	}
+
+	for name, message := range map[string]func(string) binding.Message{"structured": structured, "binary": binary} {
+		t.Run(name, func(t *testing.T) {
+			e, err := binding.ToEvent(context.Background(), message(`{"hello":"world"}`))
+			require.NoError(t, err)
+			require.Equal(t, event.ApplicationJSON, e.DataContentType())
+			require.Equal(t, []byte(`{"hello":"world"}`), e.Data())
+		})
+	}
+
+	// data which is not JSON has no content type to default to
+	e, err := binding.ToEvent(context.Background(), binary("hello world"))
+	require.NoError(t, err)
+	require.Equal(t, "", e.DataContentType())
+
+	// no data, no content type
+	e, err = binding.ToEvent(context.Background(), binary(""))
+	require.NoError(t, err)
+	require.Equal(t, "", e.DataContentType())
+}
+
+func TestToEvent_UnknownTypeInference(t *testing.T) {
+	extensions := map[string]string{
+		"exbool":    "true",
+		"exint":     "42",
+		"exfloat":   "1.5",
+		"extime":    "2020-03-21T12:34:56.78Z",
+		"exuri":     "https://example.com/path",
+		"exstring":  "hello world",
+		"exnumeric": "+42",
+	}
+	structured := func() binding.Message {
+		header := nethttp.Header{}
+		header.Set("Content-Type", event.ApplicationCloudEventsJSON)
+		body := `{"specversion":"1.0","id":"123","type":"unit.test","source":"/unit/test"`
+		for k, v := range extensions {
+			body += fmt.Sprintf(",%q:%q", k, v)
+		}
+		return http.NewMessage(header, io.NopCloser(strings.NewReader(body+"}")))
+	}
+	binary := func() binding.Message {
+		header := nethttp.Header{}
+		header.Set("ce-specversion", "1.0")
+		header.Set("ce-id", "123")
+		header.Set("ce-type", "unit.test")
+		header.Set("ce-source", "/unit/test")
+		for k, v := range extensions {
+			header.Set("ce-"+k, v)
+		}
+		return http.NewMessage(header, nil)
+	}
+
+	for name, message := range map[string]func() binding.Message{"structured": structured, "binary": binary} {
+		t.Run(name, func(t *testing.T) {
+			// Kept as strings by default
+			e, err := binding.ToEvent(context.Background(), message())
+			require.NoError(t, err)
+			for k, v := range extensions {
+				require.Equal(t, v, e.Extensions()[k])
+			}
+
+			e, err = binding.ToEvent(binding.WithUnknownTypeInference(context.Background()), message())
+			require.NoError(t, err)
+			require.Equal(t, true, e.Extensions()["exbool"])
+			require.Equal(t, int32(42), e.Extensions()["exint"])
+			require.Equal(t, "1.5", e.Extensions()["exfloat"])
+			require.Equal(t, types.Timestamp{Time: time.Date(2020, 3, 21, 12, 34, 56, 780000000, time.UTC)}, e.Extensions()["extime"])
+			require.Equal(t, *types.ParseURI("https://example.com/path"), e.Extensions()["exuri"])
+			require.Equal(t, "hello world", e.Extensions()["exstring"])
+			require.Equal(t, "+42", e.Extensions()["exnumeric"])
+
+			// Re-emitted as the same strings in binary mode, with their JSON type in structured mode
+			b, err := format.JSON.Marshal(e)
+			require.NoError(t, err)
+			var structuredOut map[string]interface{}
+			require.NoError(t, json.Unmarshal(b, &structuredOut))
+			req := httptest.NewRequest("POST", "http://localhost", nil)
+			require.NoError(t, http.WriteRequest(binding.WithForceBinary(context.Background()), binding.ToMessage(e), req))
+			for k, v := range extensions {
+				require.Equal(t, v, req.Header.Get("ce-"+k))
+				switch k {
+				case "exbool":
+					require.Equal(t, true, structuredOut[k])
+				case "exint":
+					require.Equal(t, float64(42), structuredOut[k])
+				default:
+					require.Equal(t, v, structuredOut[k])
+				}
+			}
+		})
+	}
+}
+
+func TestWithDataKey(t *testing.T) {
+	e := FullEvent()
+	e = ConvertEventExtensionsToString(t, e)
+	ctx := binding.WithDataKey(context.Background(), "payload")
+
+	req := httptest.NewRequest("POST", "http://localhost", nil)
+	require.NoError(t, http.WriteRequest(binding.WithForceStructured(ctx), binding.ToMessage(&e), req))
+	body, err := io.ReadAll(req.Body)
+	require.NoError(t, err)
+	var members map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(body, &members))
+	require.Contains(t, members, "payload")
+	require.NotContains(t, members, "data")
+
+	req.Body = io.NopCloser(strings.NewReader(string(body)))
+	got, err := binding.ToEvent(ctx, http.NewMessageFromHttpRequest(req))
+	require.NoError(t, err)
+	AssertEventEquals(t, e, ConvertEventExtensionsToString(t, *got))
+
+	// Other contexts are not affected
+	req = httptest.NewRequest("POST", "http://localhost", nil)
+	require.NoError(t, http.WriteRequest(binding.WithForceStructured(context.Background()), binding.ToMessage(&e), req))
+	body, err = io.ReadAll(req.Body)
+	require.NoError(t, err)
+	members = nil
+	require.NoError(t, json.Unmarshal(body, &members))
+	require.Contains(t, members, "data")
+	require.NotContains(t, members, "payload")
+}
@@ -13,6 +13,11 @@ package binding
 // takes Transformer(s) as parameter, it eventually converts the message to a form
 // which correctly implements MessageMetadataReader, in order to guarantee that transformation
 // is applied
+//
+// A Transformer accesses only the attributes and the extensions, never the data: when a binary
+// message is written to a BinaryWriter, the data io.Reader is passed through as is, so a large
+// data body is streamed rather than buffered, whatever the transformers. Structured messages,
+// whose attributes are part of the body, are instead converted to an Event to be transformed.
 type Transformer interface {
 	Transform(MessageMetadataReader, MessageMetadataWriter) error
 }
@@ -0,0 +1,54 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package transformer
+
+import (
+	"fmt"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/types"
+)
+
+// CoerceExtensionToInteger converts the value of a cloudevents extension, if present,
+// to an integer during the encoding process. Numeric extensions are usually carried
+// as JSON numbers in structured mode and as strings in binary mode: use it when
+// decoding, e.g. with binding.ToEvent, to get the same type regardless of the encoding.
+// The CloudEvents type system has no floating point type: fractional values are truncated.
+func CoerceExtensionToInteger(name string) binding.TransformerFunc {
+	return coerceExtension(name, func(v interface{}) (interface{}, error) {
+		return types.ToInteger(v)
+	})
+}
+
+// CoerceExtensionToBool converts the value of a cloudevents extension, if present,
+// to a boolean during the encoding process. See CoerceExtensionToInteger.
+func CoerceExtensionToBool(name string) binding.TransformerFunc {
+	return coerceExtension(name, func(v interface{}) (interface{}, error) {
+		return types.ToBool(v)
+	})
+}
+
+// CoerceExtensionToTime converts the value of a cloudevents extension, if present,
+// to a timestamp during the encoding process. See CoerceExtensionToInteger.
+func CoerceExtensionToTime(name string) binding.TransformerFunc {
+	return coerceExtension(name, func(v interface{}) (interface{}, error) {
+		return types.ToTime(v)
+	})
+}
+
+func coerceExtension(name string, convert func(interface{}) (interface{}, error)) binding.TransformerFunc {
+	return SetExtension(name, func(v interface{}) (interface{}, error) {
+		if v == nil || v == "" {
+			// missing extension
+			return nil, nil
+		}
+		coerced, err := convert(v)
+		if err != nil {
+			return nil, fmt.Errorf("cannot coerce extension %q: %w", name, err)
+		}
+		return coerced, nil
+	})
+}
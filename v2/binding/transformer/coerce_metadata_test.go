@@ -0,0 +1,69 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package transformer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	. "github.com/cloudevents/sdk-go/v2/binding/test"
+	. "github.com/cloudevents/sdk-go/v2/test"
+	"github.com/cloudevents/sdk-go/v2/types"
+)
+
+func TestCoerceExtension(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	e := MinEvent()
+	e.Context = e.Context.AsV1()
+	e.SetExtension("count", "42")
+	e.SetExtension("enabled", "false")
+	e.SetExtension("deadline", types.FormatTime(now))
+
+	want := e.Clone()
+	want.SetExtension("count", 42)
+	want.SetExtension("enabled", false)
+	want.SetExtension("deadline", now)
+
+	transformers := binding.Transformers{
+		CoerceExtensionToInteger("count"),
+		CoerceExtensionToBool("enabled"),
+		CoerceExtensionToTime("deadline"),
+		CoerceExtensionToInteger("missing"),
+	}
+
+	RunTransformerTests(t, context.Background(), []TransformerTestArgs{
+		{
+			Name:         "Coerce extensions of Mock Structured message",
+			InputMessage: MustCreateMockStructuredMessage(t, e),
+			WantEvent:    want,
+			Transformers: transformers,
+		},
+		{
+			Name:         "Coerce extensions of Mock Binary message",
+			InputMessage: MustCreateMockBinaryMessage(e),
+			WantEvent:    want,
+			Transformers: transformers,
+		},
+		{
+			Name:         "Coerce extensions of Event message",
+			InputEvent:   e,
+			WantEvent:    want,
+			Transformers: transformers,
+		},
+	})
+}
+
+func TestCoerceExtension_Invalid(t *testing.T) {
+	e := MinEvent()
+	e.SetExtension("count", "many")
+	_, err := binding.ToEvent(context.Background(), MustCreateMockBinaryMessage(e), CoerceExtensionToInteger("count"))
+	require.ErrorContains(t, err, `cannot coerce extension "count"`)
+}
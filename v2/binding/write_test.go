@@ -0,0 +1,61 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package binding_test
+
+import (
+	"context"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/binding/spec"
+	"github.com/cloudevents/sdk-go/v2/binding/transformer"
+	"github.com/cloudevents/sdk-go/v2/protocol/http"
+)
+
+// countingReader is an endless stream of zeros, counting the bytes read from it.
+type countingReader struct {
+	read int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	r.read += int64(len(p))
+	return len(p), nil
+}
+
+func TestWrite_BinaryDataStreamedThroughTransformers(t *testing.T) {
+	const size = 64 << 20
+	data := &countingReader{}
+
+	header := nethttp.Header{}
+	header.Set("ce-specversion", "1.0")
+	header.Set("ce-id", "123")
+	header.Set("ce-type", "unit.test")
+	header.Set("ce-source", "/unit/test")
+	header.Set("Content-Type", "application/octet-stream")
+	message := http.NewMessage(header, io.NopCloser(io.LimitReader(data, size)))
+
+	req := httptest.NewRequest("POST", "http://localhost", nil)
+	require.NoError(t, http.WriteRequest(context.Background(), message, req,
+		transformer.AddExtension("ext", "value"),
+		transformer.AddAttribute(spec.Subject, "subject"),
+	))
+
+	// the transformers were applied without reading the data
+	require.Equal(t, int64(0), data.read)
+	require.Equal(t, "value", req.Header.Get("ce-ext"))
+	require.Equal(t, "subject", req.Header.Get("ce-subject"))
+
+	n, err := io.Copy(io.Discard, req.Body)
+	require.NoError(t, err)
+	require.Equal(t, int64(size), n)
+}
@@ -0,0 +1,203 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+)
+
+// CircuitState is the state of the circuit breaker of the receiver.
+type CircuitState int
+
+const (
+	// CircuitClosed is the normal state: the receiver pulls the messages.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen stops the receiver pulling the messages until the cooldown elapses.
+	CircuitOpen
+	// CircuitHalfOpen lets a single message through to test whether the handler recovered.
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// CircuitStateHandler is the function signature of the callbacks invoked when
+// the circuit breaker of the receiver changes state.
+// It's invoked while the state is locked, so it must not block.
+type CircuitStateHandler func(from, to CircuitState)
+
+// circuitBreakerMinResults is the number of handler results needed in the
+// window before the error rate can open the circuit, so that a single failure
+// doesn't open it.
+const circuitBreakerMinResults = 5
+
+type circuitResult struct {
+	at     time.Time
+	failed bool
+}
+
+// circuitBreaker stops the receiver once the error rate of the receiver fn
+// exceeds the threshold over the window. It's safe to use it concurrently.
+// A nil or unconfigured circuitBreaker never opens.
+type circuitBreaker struct {
+	threshold float64
+	window    time.Duration
+	cooldown  time.Duration
+	handlers  []CircuitStateHandler
+	now       func() time.Time
+
+	mu       sync.Mutex
+	state    CircuitState
+	results  []circuitResult
+	openedAt time.Time
+	// probeAt is when the last message was let through in half-open state, zero if none.
+	probeAt time.Time
+	// changed is closed and replaced on every state change, to wake up the waiters.
+	changed chan struct{}
+}
+
+func (b *circuitBreaker) enabled() bool {
+	return b != nil && b.threshold > 0
+}
+
+// wait blocks until the circuit lets a message through, or ctx is done.
+func (b *circuitBreaker) wait(ctx context.Context) error {
+	if !b.enabled() {
+		return nil
+	}
+	for {
+		b.mu.Lock()
+		now := b.now()
+		var delay time.Duration
+		switch b.state {
+		case CircuitClosed:
+			b.mu.Unlock()
+			return nil
+		case CircuitOpen:
+			if delay = b.openedAt.Add(b.cooldown).Sub(now); delay <= 0 {
+				b.transition(CircuitHalfOpen)
+				b.mu.Unlock()
+				continue
+			}
+		case CircuitHalfOpen:
+			// The probe result may never come, e.g. the event is malformed or
+			// filtered out, so another message is let through after the cooldown.
+			if b.probeAt.IsZero() || now.Sub(b.probeAt) >= b.cooldown {
+				b.probeAt = now
+				b.mu.Unlock()
+				return nil
+			}
+			delay = b.probeAt.Add(b.cooldown).Sub(now)
+		}
+		changed := b.changed
+		b.mu.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-changed:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// record records the result of the receiver fn, which is a failure unless it's an ACK.
+func (b *circuitBreaker) record(result error) {
+	if !b.enabled() {
+		return
+	}
+	failed := !protocol.IsACK(result)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := b.now()
+	switch b.state {
+	case CircuitClosed:
+		b.results = append(b.results, circuitResult{at: now, failed: failed})
+		start := 0
+		for start < len(b.results) && now.Sub(b.results[start].at) > b.window {
+			start++
+		}
+		b.results = b.results[start:]
+		if len(b.results) < circuitBreakerMinResults {
+			return
+		}
+		failures := 0
+		for _, r := range b.results {
+			if r.failed {
+				failures++
+			}
+		}
+		if float64(failures)/float64(len(b.results)) > b.threshold {
+			b.transition(CircuitOpen)
+		}
+	case CircuitHalfOpen:
+		if failed {
+			b.transition(CircuitOpen)
+		} else {
+			b.transition(CircuitClosed)
+		}
+	case CircuitOpen:
+		// Result of a message received before the circuit opened
+	}
+}
+
+// transition must be invoked with b.mu held.
+func (b *circuitBreaker) transition(to CircuitState) {
+	from := b.state
+	b.state = to
+	b.results = nil
+	b.probeAt = time.Time{}
+	if to == CircuitOpen {
+		b.openedAt = b.now()
+	}
+	if b.changed != nil {
+		close(b.changed)
+	}
+	b.changed = make(chan struct{})
+	for _, fn := range b.handlers {
+		fn(from, to)
+	}
+}
+
+// observe returns an ObservabilityService recording the results of the
+// receiver fn in b, on top of service.
+func (b *circuitBreaker) observe(service ObservabilityService) ObservabilityService {
+	if !b.enabled() {
+		return service
+	}
+	return circuitBreakerObservability{ObservabilityService: service, breaker: b}
+}
+
+type circuitBreakerObservability struct {
+	ObservabilityService
+	breaker *circuitBreaker
+}
+
+func (o circuitBreakerObservability) RecordCallingInvoker(ctx context.Context, e *event.Event) (context.Context, func(errOrResult error)) {
+	ctx, cb := o.ObservabilityService.RecordCallingInvoker(ctx, e)
+	return ctx, func(errOrResult error) {
+		o.breaker.record(errOrResult)
+		cb(errOrResult)
+	}
+}
@@ -0,0 +1,181 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	var transitions []string
+	client := &ceClient{}
+	if err := client.applyOptions(
+		WithCircuitBreaker(0.5, time.Minute, 10*time.Second),
+		WithCircuitStateHandler(func(from, to CircuitState) {
+			transitions = append(transitions, from.String()+"->"+to.String())
+		}),
+	); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	b := client.circuitBreaker
+	b.now = func() time.Time { return now }
+
+	invoker, err := newReceiveInvoker(func(e event.Event) protocol.Result {
+		if e.ID() == "fail" {
+			return errors.New("handler failure")
+		}
+		return nil
+	}, receiveInvokerConfig{observabilityService: b.observe(noopObservabilityService{})})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	invoke := func(id string) {
+		e := event.New()
+		e.SetID(id)
+		e.SetType("unit.test")
+		e.SetSource("/unit/test")
+		_ = invoker.Invoke(context.TODO(), binding.ToMessage(&e), nil)
+	}
+	ready := func() bool {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		return b.wait(ctx) == nil
+	}
+
+	for i := 0; i < 4; i++ {
+		invoke("fail")
+	}
+	if !ready() {
+		t.Fatalf("expected the circuit to be closed with less results than the minimum")
+	}
+
+	// Failures older than the window are not counted
+	now = now.Add(2 * time.Minute)
+	for i := 0; i < 5; i++ {
+		invoke("ok")
+	}
+	for i := 0; i < 5; i++ {
+		invoke("fail")
+	}
+	if !ready() {
+		t.Fatalf("expected the circuit to be closed with an error rate of 5/10")
+	}
+	invoke("fail")
+	if b.state != CircuitOpen || ready() {
+		t.Fatalf("expected the circuit to be open; got %v", b.state)
+	}
+
+	// Half-open after the cooldown, a failing probe opens it again
+	now = now.Add(10 * time.Second)
+	if !ready() {
+		t.Fatalf("expected the circuit to let a probe through")
+	}
+	if ready() {
+		t.Fatalf("expected a single probe to be let through")
+	}
+	invoke("fail")
+	if b.state != CircuitOpen {
+		t.Fatalf("expected the circuit to be open again; got %v", b.state)
+	}
+
+	// A successful probe closes it
+	now = now.Add(10 * time.Second)
+	if !ready() {
+		t.Fatalf("expected the circuit to let a probe through")
+	}
+	invoke("ok")
+	if b.state != CircuitClosed || !ready() {
+		t.Fatalf("expected the circuit to be closed; got %v", b.state)
+	}
+
+	want := []string{"closed->open", "open->half-open", "half-open->open", "open->half-open", "half-open->closed"}
+	if len(transitions) != len(want) {
+		t.Fatalf("unexpected transitions: %v", transitions)
+	}
+	for i := range want {
+		if transitions[i] != want[i] {
+			t.Fatalf("unexpected transitions: %v", transitions)
+		}
+	}
+}
+
+func TestCircuitBreaker_Panics(t *testing.T) {
+	client := &ceClient{}
+	if err := client.applyOptions(WithCircuitBreaker(0.5, time.Minute, 10*time.Second)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	b := client.circuitBreaker
+
+	invoker, err := newReceiveInvoker(func(e event.Event) {
+		panic("boom")
+	}, receiveInvokerConfig{observabilityService: b.observe(noopObservabilityService{})})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i := 0; i < 10; i++ {
+		e := event.New()
+		e.SetID("panic")
+		e.SetType("unit.test")
+		e.SetSource("/unit/test")
+		_ = invoker.Invoke(context.TODO(), binding.ToMessage(&e), nil)
+	}
+
+	if b.state != CircuitOpen {
+		t.Fatalf("expected the panics to open the circuit; got %v", b.state)
+	}
+}
+
+func TestCircuitBreaker_WaitUnblocksOnCooldown(t *testing.T) {
+	b := &circuitBreaker{threshold: 0.5, window: time.Minute, cooldown: 20 * time.Millisecond, now: time.Now}
+	b.mu.Lock()
+	b.transition(CircuitOpen)
+	b.mu.Unlock()
+
+	start := time.Now()
+	if err := b.wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Errorf("expected wait to block until the cooldown elapsed; got %v", elapsed)
+	}
+	if b.state != CircuitHalfOpen {
+		t.Errorf("expected the circuit to be half-open; got %v", b.state)
+	}
+
+	// The probe result never comes, another probe is let through after the cooldown
+	if err := b.wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := b.wait(ctx); err == nil {
+		t.Errorf("expected wait to return the context error")
+	}
+}
+
+func TestWithCircuitBreaker_Invalid(t *testing.T) {
+	for _, opt := range []Option{
+		WithCircuitBreaker(0, time.Minute, time.Minute),
+		WithCircuitBreaker(1, time.Minute, time.Minute),
+		WithCircuitBreaker(0.5, 0, time.Minute),
+		WithCircuitBreaker(0.5, time.Minute, 0),
+		WithCircuitStateHandler(nil),
+	} {
+		client := &ceClient{}
+		if err := client.applyOptions(opt); err == nil {
+			t.Errorf("expected an error")
+		}
+	}
+}
@@ -10,8 +10,10 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"reflect"
 	"runtime"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -47,6 +49,9 @@ type Client interface {
 	// * func(event.Event) (*event.Event, protocol.Result)
 	// * func(context.Context, event.Event) *event.Event
 	// * func(context.Context, event.Event) (*event.Event, protocol.Result)
+	// If data types are registered with WithEventDataType, event.Event can also
+	// be replaced by a pointer to a registered data type, e.g.:
+	// * func(context.Context, *T) protocol.Result
 	StartReceiver(ctx context.Context, fn interface{}) error
 }
 
@@ -96,9 +101,15 @@ type ceClient struct {
 	invoker                   Invoker
 	receiverMu                sync.Mutex
 	eventDefaulterFns         []EventDefaulter
+	eventFilterFns            []EventFilter
 	pollGoroutines            int
 	blockingCallback          bool
 	ackMalformedEvent         bool
+	dataTypes                 map[string]reflect.Type
+	extensionSchemas          extensionSchemas
+	ingressTimeFn             func() time.Time
+	panics                    *panicPolicy
+	circuitBreaker            *circuitBreaker
 }
 
 func (c *ceClient) applyOptions(opts ...Option) error {
@@ -203,13 +214,17 @@ func (c *ceClient) StartReceiver(ctx context.Context, fn interface{}) error {
 		return fmt.Errorf("client already has a receiver")
 	}
 
-	invoker, err := newReceiveInvoker(
-		fn,
-		c.observabilityService,
-		c.inboundContextDecorators,
-		c.eventDefaulterFns,
-		c.ackMalformedEvent,
-	)
+	invoker, err := newReceiveInvoker(fn, receiveInvokerConfig{
+		observabilityService:     c.circuitBreaker.observe(c.observabilityService),
+		inboundContextDecorators: c.inboundContextDecorators,
+		eventDefaulterFns:        c.eventDefaulterFns,
+		eventFilterFns:           c.eventFilterFns,
+		ackMalformedEvent:        c.ackMalformedEvent,
+		dataTypes:                c.dataTypes,
+		extensionSchemas:         c.extensionSchemas,
+		ingressTimeFn:            c.ingressTimeFn,
+		panics:                   c.panics,
+	})
 	if err != nil {
 		return err
 	}
@@ -240,6 +255,11 @@ func (c *ceClient) StartReceiver(ctx context.Context, fn interface{}) error {
 				var respFn protocol.ResponseFn
 				var err error
 
+				// Stop pulling while the circuit is open
+				if c.circuitBreaker.wait(ctx) != nil {
+					return
+				}
+
 				if c.responder != nil {
 					msg, respFn, err = c.responder.Respond(ctx)
 				} else if c.receiver != nil {
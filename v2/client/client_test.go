@@ -401,6 +401,210 @@ func TestClientContext(t *testing.T) {
 	wg.Wait()
 }
 
+func TestClientRoundTrip(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error creating listener: %v", err)
+	}
+	receiver, err := client.NewHTTP(cehttp.WithListener(listener))
+	if err != nil {
+		t.Fatalf("error creating receiver: %v", err)
+	}
+	sender, err := client.NewHTTP(cehttp.WithTarget("http://" + listener.Addr().String()))
+	if err != nil {
+		t.Fatalf("error creating sender: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	received := make(chan event.Event, 1)
+	done := make(chan error)
+	go func() {
+		done <- receiver.StartReceiver(ctx, func(e event.Event) {
+			received <- e
+		})
+	}()
+
+	want := event.New()
+	want.SetID("AABBCCDDEE")
+	want.SetType("unit.test.client")
+	want.SetSource("/unit/test/client")
+	want.SetTime(time.Now().UTC())
+	want.SetExtension("exstring", "hello")
+	if err := want.SetData(event.ApplicationJSON, map[string]string{"msg": "hello"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result := sender.Send(context.Background(), want); !protocol.IsACK(result) {
+		t.Fatalf("expected ACK, got %v", result)
+	}
+	select {
+	case got := <-received:
+		if diff := cmp.Diff(want.String(), got.String()); diff != "" {
+			t.Errorf("unexpected event (-want, +got) = %v", diff)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for the event")
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestClientReceive_RequestFromContext(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error creating listener: %v", err)
+	}
+	receiver, err := client.NewHTTP(cehttp.WithListener(listener))
+	if err != nil {
+		t.Fatalf("error creating receiver: %v", err)
+	}
+	sender, err := client.NewHTTP(cehttp.WithTarget("http://"+listener.Addr().String()), cehttp.WithHeader("X-Tenant", "acme"))
+	if err != nil {
+		t.Fatalf("error creating sender: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	received := make(chan *http.Request, 1)
+	done := make(chan error)
+	go func() {
+		done <- receiver.StartReceiver(ctx, func(ctx context.Context, e event.Event) {
+			received <- cehttp.RequestFromContext(ctx)
+		})
+	}()
+
+	e := event.New()
+	e.SetID("AABBCCDDEE")
+	e.SetType("unit.test.client")
+	e.SetSource("/unit/test/client")
+	if result := sender.Send(context.Background(), e); !protocol.IsACK(result) {
+		t.Fatalf("expected ACK, got %v", result)
+	}
+	select {
+	case req := <-received:
+		if req == nil {
+			t.Fatalf("expected the request in the context")
+		}
+		if got := req.Header.Get("X-Tenant"); got != "acme" {
+			t.Errorf("X-Tenant header = %q, want %q", got, "acme")
+		}
+		if req.Body != http.NoBody {
+			t.Errorf("expected the body of the request not to be in the context")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for the event")
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestClientReceive_ResponseEvent(t *testing.T) {
+	testCases := map[string]struct {
+		structured     bool
+		respond        bool
+		wantStatus     int
+		wantStructured bool
+	}{
+		"binary request, echoed event": {
+			respond:    true,
+			wantStatus: http.StatusOK,
+		},
+		"structured request, echoed event": {
+			structured:     true,
+			respond:        true,
+			wantStatus:     http.StatusOK,
+			wantStructured: true,
+		},
+		"nil event": {
+			wantStatus: http.StatusNoContent,
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				t.Fatalf("error creating listener: %v", err)
+			}
+			receiver, err := client.NewHTTP(cehttp.WithListener(listener))
+			if err != nil {
+				t.Fatalf("error creating receiver: %v", err)
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan error)
+			go func() {
+				done <- receiver.StartReceiver(ctx, func(e event.Event) (*event.Event, protocol.Result) {
+					if !tc.respond {
+						return nil, nil
+					}
+					resp := e.Clone()
+					resp.SetType(e.Type() + ".response")
+					return &resp, nil
+				})
+			}()
+			defer func() {
+				cancel()
+				if err := <-done; err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			}()
+
+			e := event.New()
+			e.SetID("AABBCCDDEE")
+			e.SetType("unit.test.client")
+			e.SetSource("/unit/test/client")
+			if err := e.SetData(event.ApplicationJSON, map[string]string{"msg": "hello"}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			encoding := binding.WithForceBinary(context.Background())
+			if tc.structured {
+				encoding = binding.WithForceStructured(context.Background())
+			}
+			req, err := cehttp.NewHTTPRequestFromEvent(encoding, "http://"+listener.Addr().String(), e)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			resp, err := http.DefaultClient.Do(req.WithContext(context.Background()))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tc.wantStatus {
+				t.Errorf("status code = %d, want %d", resp.StatusCode, tc.wantStatus)
+			}
+			m := cehttp.NewMessageFromHttpResponse(resp)
+			if !tc.respond {
+				if got := m.ReadEncoding(); got != binding.EncodingUnknown {
+					t.Errorf("unexpected response event with encoding %v", got)
+				}
+				return
+			}
+			wantEncoding := binding.EncodingBinary
+			if tc.wantStructured {
+				wantEncoding = binding.EncodingStructured
+			}
+			if got := m.ReadEncoding(); got != wantEncoding {
+				t.Errorf("response encoding = %v, want %v", got, wantEncoding)
+			}
+			got, err := binding.ToEvent(context.Background(), m)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Type() != "unit.test.client.response" || got.ID() != e.ID() {
+				t.Errorf("unexpected response event %v", got)
+			}
+			if !bytes.Equal(got.Data(), e.Data()) {
+				t.Errorf("response data = %s, want %s", got.Data(), e.Data())
+			}
+		})
+	}
+}
+
 func TestClientStartReceiverWithAckMalformedEvent(t *testing.T) {
 	testCases := []struct {
 		name        string
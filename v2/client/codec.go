@@ -0,0 +1,56 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package client
+
+import (
+	"context"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/binding/format"
+	"github.com/cloudevents/sdk-go/v2/event"
+)
+
+// Codec describes how the outgoing events are encoded, see WithCodecSelector.
+// The zero value of each field keeps the client default.
+type Codec struct {
+	// Encoding is either binding.EncodingBinary or binding.EncodingStructured.
+	Encoding binding.Encoding
+	// Format is the event format used by the structured encoding, e.g. format.JSON.
+	Format format.Format
+	// SpecVersion is the spec version the events are converted to, e.g. event.CloudEventsVersionV03.
+	SpecVersion string
+}
+
+// CodecSelector selects the Codec of an outgoing event from the context passed to Send or Request.
+type CodecSelector func(ctx context.Context) Codec
+
+type codecKey struct{}
+
+// withCodec applies codec to the encoding process and stores it in the
+// returned context, so that codecSpecVersion can convert the event.
+func withCodec(ctx context.Context, codec Codec) context.Context {
+	switch codec.Encoding {
+	case binding.EncodingBinary:
+		ctx = binding.WithForceBinary(ctx)
+	case binding.EncodingStructured:
+		ctx = binding.WithForceStructured(ctx)
+	}
+	if codec.Format != nil {
+		ctx = binding.UseFormatForEvent(ctx, codec.Format)
+	}
+	return context.WithValue(ctx, codecKey{}, codec)
+}
+
+// codecSpecVersion is an EventDefaulter converting the event to the spec
+// version of the Codec selected for the context, if any.
+func codecSpecVersion(ctx context.Context, e event.Event) event.Event {
+	codec, ok := ctx.Value(codecKey{}).(Codec)
+	if !ok || codec.SpecVersion == "" || codec.SpecVersion == e.SpecVersion() {
+		return e
+	}
+	e.SetSpecVersion(codec.SpecVersion)
+	return e
+}
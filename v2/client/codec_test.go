@@ -0,0 +1,88 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package client
+
+import (
+	"context"
+	nethttp "net/http"
+	"testing"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+	"github.com/cloudevents/sdk-go/v2/protocol/http"
+)
+
+// requestSender writes the sent messages to HTTP requests.
+type requestSender struct {
+	requests []*nethttp.Request
+}
+
+func (s *requestSender) Send(ctx context.Context, m binding.Message, transformers ...binding.Transformer) error {
+	req, err := nethttp.NewRequest(nethttp.MethodPost, "http://localhost", nil)
+	if err != nil {
+		return err
+	}
+	if err := http.WriteRequest(ctx, m, req, transformers...); err != nil {
+		return err
+	}
+	s.requests = append(s.requests, req)
+	return nil
+}
+
+type tenantKey struct{}
+
+func TestWithCodecSelector(t *testing.T) {
+	sender := &requestSender{}
+	c, err := New(sender, WithCodecSelector(func(ctx context.Context) Codec {
+		if ctx.Value(tenantKey{}) == "legacy" {
+			return Codec{Encoding: binding.EncodingStructured, SpecVersion: event.CloudEventsVersionV03}
+		}
+		return Codec{Encoding: binding.EncodingBinary}
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	e := event.New()
+	e.SetID("123")
+	e.SetType("unit.test")
+	e.SetSource("/unit/test")
+	if err := e.SetData(event.ApplicationJSON, map[string]string{"hello": "world"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result := c.Send(context.WithValue(context.Background(), tenantKey{}, "legacy"), e); !protocol.IsACK(result) {
+		t.Fatalf("unexpected result: %v", result)
+	}
+	if result := c.Send(context.WithValue(context.Background(), tenantKey{}, "modern"), e); !protocol.IsACK(result) {
+		t.Fatalf("unexpected result: %v", result)
+	}
+	if e.SpecVersion() != event.CloudEventsVersionV1 {
+		t.Errorf("modified the original event")
+	}
+
+	legacy, modern := sender.requests[0], sender.requests[1]
+	if got := legacy.Header.Get("Content-Type"); got != event.ApplicationCloudEventsJSON {
+		t.Errorf("unexpected legacy content type; want: %s; got: %s", event.ApplicationCloudEventsJSON, got)
+	}
+	got, err := binding.ToEvent(context.Background(), http.NewMessageFromHttpRequest(legacy))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.SpecVersion() != event.CloudEventsVersionV03 {
+		t.Errorf("unexpected legacy spec version; want: %s; got: %s", event.CloudEventsVersionV03, got.SpecVersion())
+	}
+	if got := modern.Header.Get("ce-specversion"); got != event.CloudEventsVersionV1 {
+		t.Errorf("unexpected modern spec version header; want: %s; got: %s", event.CloudEventsVersionV1, got)
+	}
+}
+
+func TestWithCodecSelector_Nil(t *testing.T) {
+	if _, err := New(&requestSender{}, WithCodecSelector(nil)); err == nil {
+		t.Errorf("expected an error")
+	}
+}
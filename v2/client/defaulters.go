@@ -7,9 +7,14 @@ package client
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
 	"time"
 
+	"github.com/cloudevents/sdk-go/v2/binding/spec"
+	cecontext "github.com/cloudevents/sdk-go/v2/context"
 	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/types"
 
 	"github.com/google/uuid"
 )
@@ -30,13 +35,31 @@ func DefaultIDToUUIDIfNotSet(ctx context.Context, event event.Event) event.Event
 	return event
 }
 
+// NewDefaultIDIfNotSet returns a defaulter that will inspect the provided
+// event and assign the id returned by gen to context.ID if it is found to be
+// empty. If gen is nil, a random UUID (version 4) is assigned.
+func NewDefaultIDIfNotSet(gen func() string) EventDefaulter {
+	if gen == nil {
+		gen = func() string { return uuid.New().String() }
+	}
+	return func(ctx context.Context, event event.Event) event.Event {
+		if event.Context != nil {
+			if event.ID() == "" {
+				event.Context = event.Context.Clone()
+				event.SetID(gen())
+			}
+		}
+		return event
+	}
+}
+
 // DefaultTimeToNowIfNotSet will inspect the provided event and assign a new
-// Timestamp to context.Time if it is found to be nil or zero.
+// Timestamp, in UTC, to context.Time if it is found to be nil or zero.
 func DefaultTimeToNowIfNotSet(ctx context.Context, event event.Event) event.Event {
 	if event.Context != nil {
 		if event.Time().IsZero() {
 			event.Context = event.Context.Clone()
-			event.SetTime(time.Now())
+			event.SetTime(time.Now().UTC())
 		}
 	}
 	return event
@@ -55,3 +78,80 @@ func NewDefaultDataContentTypeIfNotSet(contentType string) EventDefaulter {
 		return event
 	}
 }
+
+// NewDefaultSpecVersionIfNotSet returns a defaulter that will inspect the
+// provided event and set the provided spec version if the event has no
+// spec version, i.e. its context is not set.
+func NewDefaultSpecVersionIfNotSet(specVersion string) EventDefaulter {
+	return func(ctx context.Context, event event.Event) event.Event {
+		if event.Context == nil {
+			event.SetSpecVersion(specVersion)
+		}
+		return event
+	}
+}
+
+// contentAddressedIDNamespace is the UUID namespace of the ids generated by
+// NewContentAddressedIDIfNotSet.
+var contentAddressedIDNamespace = uuid.NewSHA1(uuid.NameSpaceURL, []byte("https://cloudevents.io/content-addressed-id"))
+
+// NewContentAddressedIDIfNotSet returns a defaulter that will inspect the
+// provided event and, if context.ID is found to be empty, assign an id derived
+// from the values of the provided attributes and extensions and from the event
+// data. The id is a UUID (version 5), hence events with the same attribute
+// values and the same data are assigned the same id. If the id cannot be
+// derived, the error is logged and a random UUID (version 4) is assigned.
+// If no attributes are provided, type and source are used.
+func NewContentAddressedIDIfNotSet(attrs ...string) EventDefaulter {
+	if len(attrs) == 0 {
+		attrs = []string{"type", "source"}
+	}
+	return func(ctx context.Context, event event.Event) event.Event {
+		if event.Context != nil {
+			if event.ID() == "" {
+				id, err := contentAddressedID(event, attrs)
+				if err != nil {
+					cecontext.LoggerFrom(ctx).Warnf("failed to derive the event id from its content, using a random UUID: %v", err)
+					id = uuid.New().String()
+				}
+				event.Context = event.Context.Clone()
+				event.SetID(id)
+			}
+		}
+		return event
+	}
+}
+
+func contentAddressedID(e event.Event, attrs []string) (string, error) {
+	version := spec.VS.Version(e.SpecVersion())
+	values := make(map[string]interface{}, len(attrs)+1)
+	for _, name := range attrs {
+		name = strings.ToLower(name)
+		var v interface{}
+		if version != nil && version.Attribute(name) != nil {
+			v = version.Attribute(name).Get(e.Context)
+		} else {
+			v = e.Extensions()[name]
+		}
+		if v == nil {
+			continue
+		}
+		s, err := types.Format(v)
+		if err != nil {
+			return "", err
+		}
+		if s != "" {
+			values[name] = s
+		}
+	}
+	if data := e.Data(); len(data) != 0 {
+		values["data"] = data
+	}
+
+	// encoding/json sorts the map keys, so the serialization is stable
+	b, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	return uuid.NewSHA1(contentAddressedIDNamespace, b).String(), nil
+}
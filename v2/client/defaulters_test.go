@@ -10,6 +10,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/cloudevents/sdk-go/v2/event"
 )
 
@@ -64,6 +66,41 @@ func TestDefaultIDToUUIDIfNotSetImmutable(t *testing.T) {
 	}
 }
 
+func TestNewDefaultIDIfNotSet(t *testing.T) {
+	for _, tc := range versions {
+		t.Run(tc, func(t *testing.T) {
+			got := NewDefaultIDIfNotSet(func() string { return "generated" })(context.TODO(), event.New(tc))
+			if got.ID() != "generated" {
+				t.Errorf("expected generated id, got %q", got.ID())
+			}
+
+			e := event.New(tc)
+			e.SetID("abc-123")
+			got = NewDefaultIDIfNotSet(func() string { return "generated" })(context.TODO(), e)
+			if got.ID() != "abc-123" {
+				t.Errorf("id was defaulted when already set")
+			}
+		})
+	}
+}
+
+func TestNewDefaultIDIfNotSet_uuid(t *testing.T) {
+	e := event.New()
+
+	got := NewDefaultIDIfNotSet(nil)(context.TODO(), e)
+
+	if e.ID() != "" {
+		t.Errorf("modified the original event")
+	}
+	id, err := uuid.Parse(got.ID())
+	if err != nil {
+		t.Fatalf("expected a UUID id, got %q: %v", got.ID(), err)
+	}
+	if id.Version() != 4 {
+		t.Errorf("expected a version 4 UUID, got version %d", id.Version())
+	}
+}
+
 func TestDefaultTimeToNowIfNotSet_empty(t *testing.T) {
 	for _, tc := range versions {
 		t.Run(tc, func(t *testing.T) {
@@ -72,6 +109,9 @@ func TestDefaultTimeToNowIfNotSet_empty(t *testing.T) {
 			if got.Time().IsZero() {
 				t.Errorf("failed to generate time for event")
 			}
+			if got.Time().Location() != time.UTC {
+				t.Errorf("expected time in UTC, got %v", got.Time().Location())
+			}
 		})
 	}
 }
@@ -145,3 +185,104 @@ func TestNewDefaultDataContentTypeIfNotSet_set(t *testing.T) {
 		})
 	}
 }
+
+func TestNewDefaultSpecVersionIfNotSet_empty(t *testing.T) {
+	for _, tc := range versions {
+		t.Run(tc, func(t *testing.T) {
+			fn := NewDefaultSpecVersionIfNotSet(tc)
+			got := fn(context.TODO(), event.Event{})
+
+			if got.SpecVersion() != tc {
+				t.Errorf("failed to default spec version for event")
+			}
+		})
+	}
+}
+
+func TestNewDefaultSpecVersionIfNotSet_set(t *testing.T) {
+	for _, tc := range versions {
+		t.Run(tc, func(t *testing.T) {
+			e := event.New(tc)
+			e.SetID("abc")
+
+			fn := NewDefaultSpecVersionIfNotSet(event.CloudEventsVersionV1)
+			if tc == event.CloudEventsVersionV1 {
+				fn = NewDefaultSpecVersionIfNotSet(event.CloudEventsVersionV03)
+			}
+			got := fn(context.TODO(), e)
+
+			if got.SpecVersion() != tc {
+				t.Errorf("failed to preserve spec version for event")
+			}
+			if got.ID() != "abc" {
+				t.Errorf("failed to preserve id for event")
+			}
+		})
+	}
+}
+
+func TestNewContentAddressedIDIfNotSet_empty(t *testing.T) {
+	newEvent := func(version, data string) event.Event {
+		e := event.New(version)
+		e.SetType("unit.test")
+		e.SetSource("/unit/test")
+		e.SetExtension("partition", 3)
+		_ = e.SetData(event.ApplicationJSON, []byte(data))
+		return e
+	}
+
+	for _, tc := range versions {
+		t.Run(tc, func(t *testing.T) {
+			fn := NewContentAddressedIDIfNotSet("type", "source", "partition")
+
+			got := fn(context.TODO(), newEvent(tc, `{"a":1}`))
+			if got.ID() == "" {
+				t.Fatalf("failed to generate an id for event")
+			}
+			if again := fn(context.TODO(), newEvent(tc, `{"a":1}`)); again.ID() != got.ID() {
+				t.Errorf("expected the same id for the same content, got %q and %q", got.ID(), again.ID())
+			}
+			if other := fn(context.TODO(), newEvent(tc, `{"a":2}`)); other.ID() == got.ID() {
+				t.Errorf("expected a different id for different data")
+			}
+
+			e := newEvent(tc, `{"a":1}`)
+			e.SetExtension("partition", 4)
+			if other := fn(context.TODO(), e); other.ID() == got.ID() {
+				t.Errorf("expected a different id for a different extension")
+			}
+
+			e = newEvent(tc, `{"a":1}`)
+			e.SetSubject("not selected")
+			if same := fn(context.TODO(), e); same.ID() != got.ID() {
+				t.Errorf("expected the same id when a non selected attribute changes, got %q and %q", got.ID(), same.ID())
+			}
+		})
+	}
+}
+
+func TestNewContentAddressedIDIfNotSet_set(t *testing.T) {
+	e := event.New()
+	e.SetID("abc-123")
+
+	got := NewContentAddressedIDIfNotSet()(context.TODO(), e)
+
+	if got.ID() != "abc-123" {
+		t.Errorf("id was defaulted when already set")
+	}
+}
+
+func TestNewContentAddressedIDIfNotSetImmutable(t *testing.T) {
+	e := event.New()
+	e.SetType("unit.test")
+
+	got := NewContentAddressedIDIfNotSet()(context.TODO(), e)
+
+	if e.ID() != "" {
+		t.Errorf("modified the original event")
+	}
+
+	if got.ID() == "" {
+		t.Errorf("failed to generate an id for event")
+	}
+}
@@ -0,0 +1,37 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package client
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+)
+
+// extensionSchemas holds the extensions allowed for each event type, see WithExtensionSchema.
+type extensionSchemas map[string]map[string]struct{}
+
+// check returns an error naming the extensions of e which are not declared
+// for its type. Events of a type without a declared schema are not checked.
+func (s extensionSchemas) check(e *event.Event) error {
+	allowed, ok := s[e.Type()]
+	if !ok {
+		return nil
+	}
+	var undeclared []string
+	for name := range e.Extensions() {
+		if _, ok := allowed[name]; !ok {
+			undeclared = append(undeclared, name)
+		}
+	}
+	if len(undeclared) == 0 {
+		return nil
+	}
+	sort.Strings(undeclared)
+	return fmt.Errorf("event type %q does not declare the extensions: %s", e.Type(), strings.Join(undeclared, ", "))
+}
@@ -0,0 +1,28 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package client
+
+import (
+	"context"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/extensions"
+)
+
+// EventFilter is the function signature for the filters of the received events.
+// An event is delivered to the receiver fn only if all the filters return true.
+type EventFilter func(ctx context.Context, event event.Event) bool
+
+// MinSeverityFilter returns an EventFilter accepting the events whose severity
+// extension is at least min. Events without a valid severity are accepted.
+func MinSeverityFilter(min extensions.Severity) EventFilter {
+	return func(_ context.Context, e event.Event) bool {
+		if s, ok := extensions.GetSeverity(e); ok {
+			return s >= min
+		}
+		return true
+	}
+}
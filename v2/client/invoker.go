@@ -8,10 +8,13 @@ package client
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"time"
 
 	"github.com/cloudevents/sdk-go/v2/binding"
 	cecontext "github.com/cloudevents/sdk-go/v2/context"
 	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/extensions"
 	"github.com/cloudevents/sdk-go/v2/protocol"
 )
 
@@ -23,21 +26,27 @@ type Invoker interface {
 
 var _ Invoker = (*receiveInvoker)(nil)
 
-func newReceiveInvoker(
-	fn interface{},
-	observabilityService ObservabilityService,
-	inboundContextDecorators []func(context.Context, binding.Message) context.Context,
-	fns []EventDefaulter,
-	ackMalformedEvent bool,
-) (Invoker, error) {
-	r := &receiveInvoker{
-		eventDefaulterFns:        fns,
-		observabilityService:     observabilityService,
-		inboundContextDecorators: inboundContextDecorators,
-		ackMalformedEvent:        ackMalformedEvent,
+// receiveInvokerConfig holds the client settings applied by the receiveInvoker
+// to the incoming events. The zero value is a valid configuration.
+type receiveInvokerConfig struct {
+	observabilityService     ObservabilityService
+	inboundContextDecorators []func(context.Context, binding.Message) context.Context
+	eventDefaulterFns        []EventDefaulter
+	eventFilterFns           []EventFilter
+	ackMalformedEvent        bool
+	dataTypes                map[string]reflect.Type
+	extensionSchemas         extensionSchemas
+	ingressTimeFn            func() time.Time
+	panics                   *panicPolicy
+}
+
+func newReceiveInvoker(fn interface{}, config receiveInvokerConfig) (Invoker, error) {
+	if config.observabilityService == nil {
+		config.observabilityService = noopObservabilityService{}
 	}
+	r := &receiveInvoker{receiveInvokerConfig: config}
 
-	if fn, err := receiver(fn); err != nil {
+	if fn, err := receiverWithDataTypes(fn, config.dataTypes); err != nil {
 		return nil, err
 	} else {
 		r.fn = fn
@@ -47,11 +56,8 @@ func newReceiveInvoker(
 }
 
 type receiveInvoker struct {
-	fn                       *receiverFn
-	observabilityService     ObservabilityService
-	eventDefaulterFns        []EventDefaulter
-	inboundContextDecorators []func(context.Context, binding.Message) context.Context
-	ackMalformedEvent        bool
+	receiveInvokerConfig
+	fn *receiverFn
 }
 
 func (r *receiveInvoker) Invoke(ctx context.Context, m binding.Message, respFn protocol.ResponseFn) (err error) {
@@ -63,8 +69,22 @@ func (r *receiveInvoker) Invoke(ctx context.Context, m binding.Message, respFn p
 	var result protocol.Result
 
 	e, eventErr := binding.ToEvent(ctx, m)
+	if e != nil && eventErr == nil && r.extensionSchemas != nil {
+		// Reject the event whatever the receiver fn signature is
+		if schemaErr := r.extensionSchemas.check(e); schemaErr != nil {
+			r.observabilityService.RecordReceivedMalformedEvent(ctx, schemaErr)
+			return respFn(ctx, nil, protocol.NewReceipt(r.ackMalformedEvent, "extension schema violation in incoming event: %w", schemaErr))
+		}
+	}
+	if e != nil && eventErr == nil && r.ingressTimeFn != nil {
+		// the event might be shared with the sender, see binding.EventMessage
+		stamped := *e
+		stamped.Context = e.Context.Clone()
+		extensions.SetIngressTime(&stamped, r.ingressTimeFn())
+		e = &stamped
+	}
 	switch {
-	case eventErr != nil && r.fn.hasEventIn:
+	case eventErr != nil && (r.fn.hasEventIn || r.fn.hasDataIn):
 		r.observabilityService.RecordReceivedMalformedEvent(ctx, eventErr)
 		return respFn(ctx, nil, protocol.NewReceipt(r.ackMalformedEvent, "failed to convert Message to Event: %w", eventErr))
 	case r.fn != nil:
@@ -74,24 +94,32 @@ func (r *receiveInvoker) Invoke(ctx context.Context, m binding.Message, respFn p
 				r.observabilityService.RecordReceivedMalformedEvent(ctx, validationErr)
 				return respFn(ctx, nil, protocol.NewReceipt(r.ackMalformedEvent, "validation error in incoming event: %w", validationErr))
 			}
+			if !r.accepts(ctx, *e) {
+				// Filtered out events are dropped, finishing the message
+				// without error so that the protocol acknowledges it.
+				break
+			}
 		}
 
 		// Let's invoke the receiver fn
 		var resp *event.Event
 		resp, result = func() (resp *event.Event, result protocol.Result) {
+			var cb func(error)
 			defer func() {
-				if r := recover(); r != nil {
-					result = fmt.Errorf("call to Invoker.Invoke(...) has panicked: %v", r)
+				if rec := recover(); rec != nil {
+					result = fmt.Errorf("call to Invoker.Invoke(...) has panicked: %v", rec)
 					cecontext.LoggerFrom(ctx).Error(result)
+					// The panic policy may panic again, record the result first
+					defer r.panics.recovered(rec, e)
+				}
+				if cb != nil {
+					cb(result)
 				}
 			}()
 			ctx = computeInboundContext(m, ctx, r.inboundContextDecorators)
-
-			var cb func(error)
 			ctx, cb = r.observabilityService.RecordCallingInvoker(ctx, e)
 
 			resp, result = r.fn.invoke(ctx, e)
-			defer cb(result)
 			return
 		}()
 
@@ -125,6 +153,15 @@ func (r *receiveInvoker) Invoke(ctx context.Context, m binding.Message, respFn p
 	return respFn(ctx, respMsg, result)
 }
 
+func (r *receiveInvoker) accepts(ctx context.Context, e event.Event) bool {
+	for _, fn := range r.eventFilterFns {
+		if !fn(ctx, e) {
+			return false
+		}
+	}
+	return true
+}
+
 func (r *receiveInvoker) IsReceiver() bool {
 	return !r.fn.hasEventOut
 }
@@ -0,0 +1,328 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package client
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/extensions"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+	"github.com/cloudevents/sdk-go/v2/protocol/gochan"
+)
+
+func TestWithIngressTimestamp(t *testing.T) {
+	ingressTime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	p := gochan.New()
+	c, err := New(p, WithIngressTimestamp(func() time.Time { return ingressTime }))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	received := make(chan event.Event, 1)
+	go func() {
+		_ = c.StartReceiver(ctx, func(e event.Event) {
+			received <- e
+		})
+	}()
+
+	e := event.New()
+	e.SetID("123")
+	e.SetType("unit.test")
+	e.SetSource("/unit/test")
+	if err := p.Send(ctx, binding.ToMessage(&e)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	select {
+	case got := <-received:
+		if gotTime, ok := extensions.GetIngressTime(got); !ok || !gotTime.Equal(ingressTime) {
+			t.Errorf("unexpected ingresstime; want: %v; got: %v", ingressTime, gotTime)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for the event")
+	}
+	if _, ok := e.Extensions()[extensions.IngressTimeExtension]; ok {
+		t.Errorf("modified the original event")
+	}
+}
+
+func TestWithIngressTimestamp_DefaultClock(t *testing.T) {
+	client := &ceClient{}
+	if err := client.applyOptions(WithIngressTimestamp(nil)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if client.ingressTimeFn == nil {
+		t.Fatalf("expected ingressTimeFn to be set")
+	}
+	if loc := client.ingressTimeFn().Location(); loc != time.UTC {
+		t.Errorf("expected a UTC time, got %v", loc)
+	}
+}
+
+func TestReceiveInvoker_ExtensionSchema(t *testing.T) {
+	client := &ceClient{}
+	if err := client.applyOptions(WithExtensionSchema("unit.test", []string{"Allowed"})); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	testCases := map[string]struct {
+		eventType  string
+		extensions map[string]string
+		wantErr    string
+	}{
+		"declared extension": {
+			eventType:  "unit.test",
+			extensions: map[string]string{"allowed": "yes"},
+		},
+		"undeclared extensions": {
+			eventType:  "unit.test",
+			extensions: map[string]string{"allowed": "yes", "sprawl": "no", "other": "no"},
+			wantErr:    `event type "unit.test" does not declare the extensions: other, sprawl`,
+		},
+		"type without schema": {
+			eventType:  "other.test",
+			extensions: map[string]string{"sprawl": "no"},
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			invoked := false
+			invoker, err := newReceiveInvoker(func(e event.Event) {
+				invoked = true
+			}, receiveInvokerConfig{extensionSchemas: client.extensionSchemas})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			e := event.New()
+			e.SetID("123")
+			e.SetType(tc.eventType)
+			e.SetSource("/unit/test")
+			for k, v := range tc.extensions {
+				e.SetExtension(k, v)
+			}
+			var result error
+			err = invoker.Invoke(context.TODO(), binding.ToMessage(&e), func(_ context.Context, _ binding.Message, r protocol.Result, _ ...binding.Transformer) error {
+				result = r
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tc.wantErr == "" {
+				if !invoked || result != nil {
+					t.Errorf("expected the receiver to be invoked; got result: %v", result)
+				}
+				return
+			}
+			if invoked {
+				t.Errorf("expected the receiver not to be invoked")
+			}
+			if !protocol.IsNACK(result) || !strings.Contains(result.Error(), tc.wantErr) {
+				t.Errorf("unexpected result; want NACK with: %s; got: %v", tc.wantErr, result)
+			}
+		})
+	}
+}
+
+func TestReceiveInvoker_ExtensionSchemaWithoutEventIn(t *testing.T) {
+	client := &ceClient{}
+	if err := client.applyOptions(WithExtensionSchema("unit.test", []string{"allowed"})); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	invoked := false
+	invoker, err := newReceiveInvoker(func(ctx context.Context) error {
+		invoked = true
+		return nil
+	}, receiveInvokerConfig{extensionSchemas: client.extensionSchemas})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	e := event.New()
+	e.SetID("123")
+	e.SetType("unit.test")
+	e.SetSource("/unit/test")
+	e.SetExtension("sprawl", "no")
+	var result error
+	err = invoker.Invoke(context.TODO(), binding.ToMessage(&e), func(_ context.Context, _ binding.Message, r protocol.Result, _ ...binding.Transformer) error {
+		result = r
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if invoked {
+		t.Errorf("expected the receiver not to be invoked")
+	}
+	if want := `event type "unit.test" does not declare the extensions: sprawl`; !protocol.IsNACK(result) || !strings.Contains(result.Error(), want) {
+		t.Errorf("unexpected result; want NACK with: %s; got: %v", want, result)
+	}
+}
+
+func TestReceiveInvoker_MinSeverity(t *testing.T) {
+	client := &ceClient{}
+	if err := client.applyOptions(WithMinSeverity(extensions.SeverityWarn)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	testCases := map[string]struct {
+		severity    string
+		wantInvoked bool
+	}{
+		"below":       {severity: "info"},
+		"equal":       {severity: "warn", wantInvoked: true},
+		"above":       {severity: "error", wantInvoked: true},
+		"no severity": {wantInvoked: true},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			invoked := false
+			invoker, err := newReceiveInvoker(func(e event.Event) {
+				invoked = true
+			}, receiveInvokerConfig{eventFilterFns: client.eventFilterFns})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			e := event.New()
+			e.SetID("123")
+			e.SetType("unit.test")
+			e.SetSource("/unit/test")
+			if tc.severity != "" {
+				e.SetExtension(extensions.SeverityExtension, tc.severity)
+			}
+			var result error
+			err = invoker.Invoke(context.TODO(), binding.ToMessage(&e), func(_ context.Context, _ binding.Message, r protocol.Result, _ ...binding.Transformer) error {
+				result = r
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if invoked != tc.wantInvoked {
+				t.Errorf("unexpected invocation; want: %v; got: %v", tc.wantInvoked, invoked)
+			}
+			if !tc.wantInvoked && !protocol.IsACK(result) {
+				t.Errorf("expected the filtered event to be acknowledged; got: %v", result)
+			}
+		})
+	}
+}
+
+func TestReceiveInvoker_FilteredEventFinishesWithoutError(t *testing.T) {
+	client := &ceClient{}
+	if err := client.applyOptions(WithMinSeverity(extensions.SeverityWarn)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	invoked := false
+	invoker, err := newReceiveInvoker(func(e event.Event) {
+		invoked = true
+	}, receiveInvokerConfig{eventFilterFns: client.eventFilterFns})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	e := event.New()
+	e.SetID("123")
+	e.SetType("unit.test")
+	e.SetSource("/unit/test")
+	e.SetExtension(extensions.SeverityExtension, "info")
+
+	// Protocols like AMQP settle the message depending on the error passed
+	// to Finish: any non-nil error, even an ACK result, rejects it.
+	finished := false
+	var finishErr error
+	m := binding.WithFinish(binding.ToMessage(&e), func(err error) {
+		finished = true
+		finishErr = err
+	})
+	// receivers which are not responders are invoked with noRespFn
+	if err := invoker.Invoke(context.TODO(), m, noRespFn); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if invoked {
+		t.Errorf("expected the receiver not to be invoked")
+	}
+	if !finished || finishErr != nil {
+		t.Errorf("expected the message to be finished without error; finished: %v; got: %v", finished, finishErr)
+	}
+}
+
+func TestReceiveInvoker_PanicHandler(t *testing.T) {
+	var recovered []interface{}
+	var handledIDs []string
+	client := &ceClient{}
+	if err := client.applyOptions(
+		WithPanicHandler(func(rec interface{}, e *event.Event) {
+			recovered = append(recovered, rec)
+			handledIDs = append(handledIDs, e.ID())
+		}),
+		WithRepanicAfter(2),
+	); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	invoker, err := newReceiveInvoker(func(e event.Event) {
+		panic("boom " + e.ID())
+	}, receiveInvokerConfig{panics: client.panics})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	invoke := func(id string) error {
+		e := event.New()
+		e.SetID(id)
+		e.SetType("unit.test")
+		e.SetSource("/unit/test")
+		var result error
+		err := invoker.Invoke(context.TODO(), binding.ToMessage(&e), func(_ context.Context, _ binding.Message, r protocol.Result, _ ...binding.Transformer) error {
+			result = r
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return result
+	}
+
+	if result := invoke("1"); protocol.IsACK(result) {
+		t.Errorf("expected the panic to NACK the event; got: %v", result)
+	}
+	if len(recovered) != 1 || recovered[0] != "boom 1" || handledIDs[0] != "1" {
+		t.Errorf("unexpected panic handler calls; recovered: %v; ids: %v", recovered, handledIDs)
+	}
+
+	defer func() {
+		if rec := recover(); rec != "boom 2" {
+			t.Errorf("expected the second panic to be raised again; got: %v", rec)
+		}
+		if len(recovered) != 2 {
+			t.Errorf("expected the handler to be invoked before panicking again; got %d calls", len(recovered))
+		}
+	}()
+	invoke("2")
+	t.Errorf("expected a panic")
+}
+
+func TestWithRepanicAfter_Invalid(t *testing.T) {
+	client := &ceClient{}
+	if err := client.applyOptions(WithRepanicAfter(0)); err == nil {
+		t.Errorf("expected an error")
+	}
+	if err := client.applyOptions(WithPanicHandler(nil)); err == nil {
+		t.Errorf("expected an error")
+	}
+}
@@ -8,8 +8,14 @@ package client
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"strings"
+	"time"
 
 	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/event/datacodec"
+	"github.com/cloudevents/sdk-go/v2/extensions"
 )
 
 // Option is the function signature required to be considered an client.Option.
@@ -46,6 +52,26 @@ func WithForceStructured() Option {
 	}
 }
 
+// WithCodecSelector makes the client select the encoding, the structured format
+// and the spec version of each event sent with Send or Request by calling fn with
+// the context of the call, e.g. to encode the events of each tenant of a gateway
+// as expected by its endpoint. The spec version conversion is applied at the
+// position of this option in the defaulter chain.
+func WithCodecSelector(fn CodecSelector) Option {
+	return func(i interface{}) error {
+		if c, ok := i.(*ceClient); ok {
+			if fn == nil {
+				return fmt.Errorf("client option was given a nil codec selector")
+			}
+			c.outboundContextDecorators = append(c.outboundContextDecorators, func(ctx context.Context) context.Context {
+				return withCodec(ctx, fn(ctx))
+			})
+			c.eventDefaulterFns = append(c.eventDefaulterFns, codecSpecVersion)
+		}
+		return nil
+	}
+}
+
 // WithUUIDs adds DefaultIDToUUIDIfNotSet event defaulter to the end of the
 // defaulter chain.
 func WithUUIDs() Option {
@@ -57,6 +83,38 @@ func WithUUIDs() Option {
 	}
 }
 
+// WithIDGenerator adds an event defaulter that assigns the id returned by gen
+// to the events sent without one. Ids already set are left untouched. If gen
+// is nil, random UUIDs (version 4) are assigned, as with WithUUIDs.
+// See NewDefaultIDIfNotSet.
+func WithIDGenerator(gen func() string) Option {
+	return func(i interface{}) error {
+		if c, ok := i.(*ceClient); ok {
+			c.eventDefaulterFns = append(c.eventDefaulterFns, NewDefaultIDIfNotSet(gen))
+		}
+		return nil
+	}
+}
+
+// WithContentAddressedID adds an event defaulter that derives the id of the
+// events missing one from the provided attributes and extensions and from the
+// event data, so that re-sending the same logical event yields the same id.
+// If no attributes are provided, type and source are used.
+// See NewContentAddressedIDIfNotSet.
+func WithContentAddressedID(attrs ...string) Option {
+	return func(i interface{}) error {
+		if c, ok := i.(*ceClient); ok {
+			for _, attr := range attrs {
+				if strings.EqualFold(attr, "id") {
+					return fmt.Errorf("client option was given the id attribute to derive the id from")
+				}
+			}
+			c.eventDefaulterFns = append(c.eventDefaulterFns, NewContentAddressedIDIfNotSet(attrs...))
+		}
+		return nil
+	}
+}
+
 // WithTimeNow adds DefaultTimeToNowIfNotSet event defaulter to the end of the
 // defaulter chain.
 func WithTimeNow() Option {
@@ -68,6 +126,27 @@ func WithTimeNow() Option {
 	}
 }
 
+// WithDefaultSpecVersion adds NewDefaultSpecVersionIfNotSet event defaulter
+// to the start of the defaulter chain, so that the other defaulters, which
+// skip events without a context, apply to the events it defaults. The
+// provided spec version must be one of the versions supported by the event
+// package. Note that events created with event.New already have a spec
+// version: this applies to events built without a context, e.g. event.Event{}.
+func WithDefaultSpecVersion(specVersion string) Option {
+	return func(i interface{}) error {
+		if c, ok := i.(*ceClient); ok {
+			switch specVersion {
+			case event.CloudEventsVersionV03, event.CloudEventsVersionV1:
+			default:
+				return fmt.Errorf("client option was given an unsupported spec version %q, expected one of [%s, %s]",
+					specVersion, event.CloudEventsVersionV03, event.CloudEventsVersionV1)
+			}
+			c.eventDefaulterFns = append([]EventDefaulter{NewDefaultSpecVersionIfNotSet(specVersion)}, c.eventDefaulterFns...)
+		}
+		return nil
+	}
+}
+
 // WithTracePropagation enables trace propagation via the distributed tracing
 // extension.
 // Deprecated: this is now noop and will be removed in future releases.
@@ -139,3 +218,196 @@ func WithAckMalformedEvent() Option {
 		return nil
 	}
 }
+
+// WithEventFilter adds a filter of the events received within StartReceiver.
+// The events rejected by the filter are acknowledged and dropped, without
+// invoking the receiver fn.
+func WithEventFilter(fn EventFilter) Option {
+	return func(i interface{}) error {
+		if c, ok := i.(*ceClient); ok {
+			if fn == nil {
+				return fmt.Errorf("client option was given a nil event filter")
+			}
+			c.eventFilterFns = append(c.eventFilterFns, fn)
+		}
+		return nil
+	}
+}
+
+// WithMinSeverity adds MinSeverityFilter to the event filters, so that the events
+// received within StartReceiver whose severity extension is below min are
+// acknowledged and dropped.
+func WithMinSeverity(min extensions.Severity) Option {
+	return WithEventFilter(MinSeverityFilter(min))
+}
+
+// WithEventDataType registers the Go type of obj as the data type of the events
+// of the given type. obj can be either a value or a pointer to a value of that type.
+// When at least one data type is registered, the fn passed to StartReceiver can
+// accept a pointer to a registered data type in place of event.Event, e.g.
+// func(context.Context, *OrderCreated) error. The event data is then decoded
+// into a new value of the type registered for the incoming event type: events
+// with an unregistered type, registered with a different data type or whose data
+// fails to decode are not acknowledged.
+func WithEventDataType(eventType string, obj interface{}) Option {
+	return func(i interface{}) error {
+		if c, ok := i.(*ceClient); ok {
+			if eventType == "" {
+				return fmt.Errorf("client option was given an empty event type")
+			}
+			t := reflect.TypeOf(obj)
+			if t == nil {
+				return fmt.Errorf("client option was given a nil data type for event type %q", eventType)
+			}
+			if t.Kind() == reflect.Ptr {
+				t = t.Elem()
+			}
+			if c.dataTypes == nil {
+				c.dataTypes = make(map[string]reflect.Type)
+			}
+			c.dataTypes[eventType] = t
+		}
+		return nil
+	}
+}
+
+// WithExtensionSchema declares the extensions allowed for the events of the given
+// type. The events of that type received within StartReceiver which have any other
+// extension are rejected as malformed, with an error naming the undeclared extensions,
+// before invoking the receiver fn. Events of types without a declared schema are
+// not checked. Extension names are case-insensitive, as in event.Event.SetExtension.
+func WithExtensionSchema(eventType string, allowed []string) Option {
+	return func(i interface{}) error {
+		if c, ok := i.(*ceClient); ok {
+			if eventType == "" {
+				return fmt.Errorf("client option was given an empty event type")
+			}
+			names := make(map[string]struct{}, len(allowed))
+			for _, name := range allowed {
+				names[strings.ToLower(name)] = struct{}{}
+			}
+			if c.extensionSchemas == nil {
+				c.extensionSchemas = make(extensionSchemas)
+			}
+			c.extensionSchemas[eventType] = names
+		}
+		return nil
+	}
+}
+
+// WithSchemaResolver configures the schema resolver used by the data decoders
+// to resolve the dataschema of the received events, e.g. to decode Avro or
+// Protobuf data. The resolver is added to the context passed to the receiver
+// fn: decoding the data with event.DataAsContext and this context gives the
+// resolved schema to the decoders registered with datacodec.AddSchemaDecoder.
+func WithSchemaResolver(r datacodec.SchemaResolver) Option {
+	return func(i interface{}) error {
+		if c, ok := i.(*ceClient); ok {
+			if r == nil {
+				return fmt.Errorf("client option was given a nil schema resolver")
+			}
+			c.inboundContextDecorators = append(c.inboundContextDecorators, func(ctx context.Context, _ binding.Message) context.Context {
+				return datacodec.WithSchemaResolver(ctx, r)
+			})
+		}
+		return nil
+	}
+}
+
+// WithIngressTimestamp stamps the events received within StartReceiver with
+// the ingresstime extension, set to the time returned by now when the client
+// received the event, before invoking the receiver fn. If now is nil, the
+// current UTC time is used. See extensions.IngressLatency and
+// extensions.Latency to compute the latency of the received events.
+func WithIngressTimestamp(now func() time.Time) Option {
+	return func(i interface{}) error {
+		if c, ok := i.(*ceClient); ok {
+			c.ingressTimeFn = now
+			if c.ingressTimeFn == nil {
+				c.ingressTimeFn = func() time.Time { return time.Now().UTC() }
+			}
+		}
+		return nil
+	}
+}
+
+// WithPanicHandler adds a handler invoked when the fn passed to StartReceiver
+// panics. The panic is recovered and the message is not acknowledged, so a
+// single bad event doesn't stop the receiver: fn can be used to report the bug.
+func WithPanicHandler(fn PanicHandler) Option {
+	return func(i interface{}) error {
+		if c, ok := i.(*ceClient); ok {
+			if fn == nil {
+				return fmt.Errorf("client option was given a nil panic handler")
+			}
+			if c.panics == nil {
+				c.panics = &panicPolicy{}
+			}
+			c.panics.handlers = append(c.panics.handlers, fn)
+		}
+		return nil
+	}
+}
+
+// WithRepanicAfter makes the receiver panic again once the fn passed to StartReceiver
+// has panicked n times, after the panic handlers are invoked, rather than keep
+// recovering the panics.
+func WithRepanicAfter(n int) Option {
+	return func(i interface{}) error {
+		if c, ok := i.(*ceClient); ok {
+			if n <= 0 {
+				return fmt.Errorf("client option was given an invalid number of panics: %d", n)
+			}
+			if c.panics == nil {
+				c.panics = &panicPolicy{}
+			}
+			c.panics.repanicAfter = int64(n)
+		}
+		return nil
+	}
+}
+
+// WithCircuitBreaker makes the receiver stop pulling the messages, i.e. opens
+// the circuit, once the ratio of the results of the fn passed to StartReceiver
+// which are not an ACK exceeds threshold over the last window, provided there
+// are at least 5 results in the window. After cooldown, the circuit is half-open:
+// a single message is let through, and the circuit closes if its result is an
+// ACK, otherwise it opens again. See WithCircuitStateHandler to be notified of
+// the state changes.
+func WithCircuitBreaker(threshold float64, window time.Duration, cooldown time.Duration) Option {
+	return func(i interface{}) error {
+		if c, ok := i.(*ceClient); ok {
+			if threshold <= 0 || threshold >= 1 {
+				return fmt.Errorf("client option was given an invalid circuit breaker threshold: %v, expected a value between 0 and 1", threshold)
+			}
+			if window <= 0 || cooldown <= 0 {
+				return fmt.Errorf("client option was given an invalid circuit breaker window or cooldown: %v, %v", window, cooldown)
+			}
+			if c.circuitBreaker == nil {
+				c.circuitBreaker = &circuitBreaker{}
+			}
+			c.circuitBreaker.threshold = threshold
+			c.circuitBreaker.window = window
+			c.circuitBreaker.cooldown = cooldown
+			c.circuitBreaker.now = time.Now
+		}
+		return nil
+	}
+}
+
+// WithCircuitStateHandler adds a handler invoked when the circuit breaker
+// configured WithCircuitBreaker changes state.
+func WithCircuitStateHandler(fn CircuitStateHandler) Option {
+	return func(i interface{}) error {
+		if c, ok := i.(*ceClient); ok {
+			if fn == nil {
+				return fmt.Errorf("client option was given a nil circuit state handler")
+			}
+			if c.circuitBreaker == nil {
+				c.circuitBreaker = &circuitBreaker{}
+			}
+			c.circuitBreaker.handlers = append(c.circuitBreaker.handlers, fn)
+		}
+		return nil
+	}
+}
@@ -7,9 +7,14 @@ package client
 
 import (
 	"context"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/event/datacodec"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+	"github.com/cloudevents/sdk-go/v2/types"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -109,6 +114,16 @@ func TestWith_Defaulters(t *testing.T) {
 			opts: []Option{WithUUIDs(), WithTimeNow()},
 			want: 2,
 		},
+		"spec version": {
+			c:    &ceClient{},
+			opts: []Option{WithDefaultSpecVersion(event.CloudEventsVersionV03)},
+			want: 1,
+		},
+		"unsupported spec version": {
+			c:       &ceClient{},
+			opts:    []Option{WithDefaultSpecVersion("0.2")},
+			wantErr: `client option was given an unsupported spec version "0.2", expected one of [0.3, 1.0]`,
+		},
 	}
 	for n, tc := range testCases {
 		t.Run(n, func(t *testing.T) {
@@ -164,3 +179,180 @@ func TestWithAckMalformedEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestWithEventDataType(t *testing.T) {
+	type orderCreated struct{}
+
+	testCases := []struct {
+		name     string
+		opts     []Option
+		expected map[string]reflect.Type
+		wantErr  bool
+	}{
+		{
+			name: "unset",
+		},
+		{
+			name: "value and pointer",
+			opts: []Option{
+				WithEventDataType("order.created", orderCreated{}),
+				WithEventDataType("order.updated", &orderCreated{}),
+			},
+			expected: map[string]reflect.Type{
+				"order.created": reflect.TypeOf(orderCreated{}),
+				"order.updated": reflect.TypeOf(orderCreated{}),
+			},
+		},
+		{
+			name:    "empty event type",
+			opts:    []Option{WithEventDataType("", orderCreated{})},
+			wantErr: true,
+		},
+		{
+			name:    "nil data type",
+			opts:    []Option{WithEventDataType("order.created", nil)},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &ceClient{}
+			err := client.applyOptions(tc.opts...)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(tc.expected, client.dataTypes) {
+				t.Errorf("unexpected dataTypes; want: %v; got: %v", tc.expected, client.dataTypes)
+			}
+		})
+	}
+}
+
+func TestWithDefaultSpecVersion_Order(t *testing.T) {
+	client := &ceClient{}
+	if err := client.applyOptions(WithUUIDs(), WithTimeNow(), WithDefaultSpecVersion(event.CloudEventsVersionV03)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	e := event.Event{}
+	for _, fn := range client.eventDefaulterFns {
+		e = fn(context.Background(), e)
+	}
+	if e.SpecVersion() != event.CloudEventsVersionV03 {
+		t.Errorf("unexpected spec version; want: %s; got: %s", event.CloudEventsVersionV03, e.SpecVersion())
+	}
+	if e.ID() == "" {
+		t.Errorf("expected the id to be defaulted")
+	}
+	if e.Time().IsZero() {
+		t.Errorf("expected the time to be defaulted")
+	}
+}
+
+func TestWithIDGenerator(t *testing.T) {
+	sender := &requestSender{}
+	c, err := New(sender, WithIDGenerator(func() string { return "generated" }))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	e := event.New()
+	e.SetType("unit.test")
+	e.SetSource("/unit/test")
+	if result := c.Send(context.Background(), e); !protocol.IsACK(result) {
+		t.Fatalf("unexpected result: %v", result)
+	}
+	e.SetID("abc-123")
+	if result := c.Send(context.Background(), e); !protocol.IsACK(result) {
+		t.Fatalf("unexpected result: %v", result)
+	}
+
+	for i, want := range []string{"generated", "abc-123"} {
+		if got := sender.requests[i].Header.Get("ce-id"); got != want {
+			t.Errorf("unexpected id of event %d; want: %s; got: %s", i, want, got)
+		}
+	}
+}
+
+func TestWithTimeNow(t *testing.T) {
+	sender := &requestSender{}
+	c, err := New(sender, WithTimeNow())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	e := event.New()
+	e.SetType("unit.test")
+	e.SetSource("/unit/test")
+	if result := c.Send(context.Background(), e); protocol.IsACK(result) {
+		t.Fatalf("expected the event without id to be rejected")
+	}
+	e.SetID("abc-123")
+	before := time.Now()
+	if result := c.Send(context.Background(), e); !protocol.IsACK(result) {
+		t.Fatalf("unexpected result: %v", result)
+	}
+	preset := time.Date(2020, 3, 21, 12, 34, 56, 0, time.UTC)
+	e.SetTime(preset)
+	if result := c.Send(context.Background(), e); !protocol.IsACK(result) {
+		t.Fatalf("unexpected result: %v", result)
+	}
+
+	if len(sender.requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(sender.requests))
+	}
+	got, err := types.ParseTime(sender.requests[0].Header.Get("ce-time"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Before(before.Truncate(time.Microsecond)) || got.After(time.Now()) {
+		t.Errorf("unexpected defaulted time: %v", got)
+	}
+	if got, want := sender.requests[1].Header.Get("ce-time"), types.FormatTime(preset); got != want {
+		t.Errorf("unexpected preset time; want: %s; got: %s", want, got)
+	}
+}
+
+func TestWithContentAddressedID(t *testing.T) {
+	client := &ceClient{}
+	if err := client.applyOptions(WithContentAddressedID("type", "source")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(client.eventDefaulterFns) != 1 {
+		t.Fatalf("expected 1 defaulter, got %d", len(client.eventDefaulterFns))
+	}
+
+	client = &ceClient{}
+	if err := client.applyOptions(WithContentAddressedID("type", "ID")); err == nil {
+		t.Errorf("expected error deriving the id from itself")
+	}
+}
+
+type noopSchemaResolver struct{}
+
+func (noopSchemaResolver) Resolve(context.Context, string) (*datacodec.Schema, error) {
+	return nil, nil
+}
+
+func TestWithSchemaResolver(t *testing.T) {
+	client := &ceClient{}
+	if err := client.applyOptions(WithSchemaResolver(nil)); err == nil {
+		t.Errorf("expected error for nil schema resolver")
+	}
+
+	client = &ceClient{}
+	if err := client.applyOptions(WithSchemaResolver(noopSchemaResolver{})); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctx := computeInboundContext(nil, context.TODO(), client.inboundContextDecorators)
+	if _, ok := datacodec.SchemaResolverFrom(ctx).(noopSchemaResolver); !ok {
+		t.Errorf("expected the schema resolver in the inbound context")
+	}
+}
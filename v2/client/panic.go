@@ -0,0 +1,40 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package client
+
+import (
+	"sync/atomic"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+)
+
+// PanicHandler is the function signature of the callbacks invoked when the
+// receiver fn panics, with the recovered value and the event being handled.
+// The event is nil if the message could not be converted to an event.
+type PanicHandler func(recovered interface{}, event *event.Event)
+
+// panicPolicy configures what the invoker does once a panic of the receiver fn is recovered.
+type panicPolicy struct {
+	handlers []PanicHandler
+	// repanicAfter is the number of recovered panics after which the invoker
+	// panics again, with the recovered value. 0 means never.
+	repanicAfter int64
+	count        int64
+}
+
+// recovered invokes the handlers and panics with rec if the panics are more than allowed.
+// It's safe to call it concurrently.
+func (p *panicPolicy) recovered(rec interface{}, e *event.Event) {
+	if p == nil {
+		return
+	}
+	for _, fn := range p.handlers {
+		fn(rec, e)
+	}
+	if count := atomic.AddInt64(&p.count, 1); p.repanicAfter > 0 && count >= p.repanicAfter {
+		panic(rec)
+	}
+}
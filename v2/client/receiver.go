@@ -25,6 +25,11 @@ type receiverFn struct {
 
 	hasContextIn bool
 	hasEventIn   bool
+	hasDataIn    bool
+
+	// dataTypes maps the event types to the Go types their data is decoded into
+	// when the fn accepts the decoded data rather than the event.
+	dataTypes map[string]reflect.Type
 
 	hasEventOut  bool
 	hasResultOut bool
@@ -58,15 +63,25 @@ var (
 // * func(context.Context, event.Event) *event.Event
 // * func(context.Context, event.Event) (*event.Event, protocol.Result)
 func receiver(fn interface{}) (*receiverFn, error) {
+	return receiverWithDataTypes(fn, nil)
+}
+
+// receiverWithDataTypes creates a receiverFn like receiver, additionally
+// accepting fn signatures where event.Event is replaced by a pointer to one of
+// the Go types in dataTypes, e.g.:
+// * func(context.Context, *T) protocol.Result
+// The event data is decoded into the type registered for the incoming event type.
+func receiverWithDataTypes(fn interface{}, dataTypes map[string]reflect.Type) (*receiverFn, error) {
 	fnType := reflect.TypeOf(fn)
 	if fnType.Kind() != reflect.Func {
 		return nil, errors.New("must pass a function to handle events")
 	}
 
 	r := &receiverFn{
-		fnValue: reflect.ValueOf(fn),
-		numIn:   fnType.NumIn(),
-		numOut:  fnType.NumOut(),
+		fnValue:   reflect.ValueOf(fn),
+		numIn:     fnType.NumIn(),
+		numOut:    fnType.NumOut(),
+		dataTypes: dataTypes,
 	}
 
 	if err := r.validate(fnType); err != nil {
@@ -86,6 +101,13 @@ func (r *receiverFn) invoke(ctx context.Context, e *event.Event) (*event.Event,
 		if r.hasEventIn {
 			args = append(args, reflect.ValueOf(*e))
 		}
+		if r.hasDataIn {
+			data, err := r.decodeData(ctx, e)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, data)
+		}
 	}
 	v := r.fnValue.Call(args)
 	var respOut protocol.Result
@@ -107,27 +129,62 @@ func (r *receiverFn) invoke(ctx context.Context, e *event.Event) (*event.Event,
 	return eOut, respOut
 }
 
+// decodeData decodes the event data into a new value of the Go type registered
+// for the event type, returning a pointer to it.
+func (r *receiverFn) decodeData(ctx context.Context, e *event.Event) (reflect.Value, protocol.Result) {
+	dataType, ok := r.dataTypes[e.Type()]
+	if !ok {
+		return reflect.Value{}, protocol.NewReceipt(false, "no data type registered for event type %q", e.Type())
+	}
+	if want := r.fnValue.Type().In(r.numIn - 1).Elem(); dataType != want {
+		return reflect.Value{}, protocol.NewReceipt(false, "event type %q is registered with data type %s, but the receiver expects %s", e.Type(), dataType, want)
+	}
+	data := reflect.New(dataType)
+	if err := e.DataAsContext(ctx, data.Interface()); err != nil {
+		return reflect.Value{}, protocol.NewReceipt(false, "failed to decode data of event type %q into %s: %w", e.Type(), dataType, err)
+	}
+	return data, nil
+}
+
+// isDataType reports whether t is a pointer to one of the registered data types.
+func (r *receiverFn) isDataType(t reflect.Type) bool {
+	if t.Kind() != reflect.Ptr {
+		return false
+	}
+	for _, dataType := range r.dataTypes {
+		if t.Elem() == dataType {
+			return true
+		}
+	}
+	return false
+}
+
 // Verifies that the inputs to a function have a valid signature
 // Valid input is to be [0, all] of
 // context.Context, event.Event in this order.
 func (r *receiverFn) validateInParamSignature(fnType reflect.Type) error {
 	r.hasContextIn = false
 	r.hasEventIn = false
+	r.hasDataIn = false
 
 	switch fnType.NumIn() {
 	case 2:
-		// has to be (context.Context, event.Event)
-		if !eventType.ConvertibleTo(fnType.In(1)) {
-			return fmt.Errorf("%s; cannot convert parameter 2 to %s from event.Event", inParamUsage, fnType.In(1))
-		} else {
+		// has to be (context.Context, event.Event) or (context.Context, *T) with T a registered data type
+		if eventType.ConvertibleTo(fnType.In(1)) {
 			r.hasEventIn = true
+		} else if r.isDataType(fnType.In(1)) {
+			r.hasDataIn = true
+		} else {
+			return fmt.Errorf("%s; cannot convert parameter 2 to %s from event.Event", inParamUsage, fnType.In(1))
 		}
 		fallthrough
 	case 1:
 		if !contextType.ConvertibleTo(fnType.In(0)) {
-			if !eventType.ConvertibleTo(fnType.In(0)) {
+			if fnType.NumIn() == 1 && r.isDataType(fnType.In(0)) {
+				r.hasDataIn = true
+			} else if !eventType.ConvertibleTo(fnType.In(0)) {
 				return fmt.Errorf("%s; cannot convert parameter 1 to %s from context.Context or event.Event", inParamUsage, fnType.In(0))
-			} else if r.hasEventIn {
+			} else if r.hasEventIn || r.hasDataIn {
 				return fmt.Errorf("%s; duplicate parameter of type event.Event", inParamUsage)
 			} else {
 				r.hasEventIn = true
@@ -8,6 +8,8 @@ package client
 import (
 	"context"
 	"errors"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -241,6 +243,125 @@ func TestReceiverFnInvoke_5(t *testing.T) {
 	}
 }
 
+type orderCreated struct {
+	OrderID string `json:"orderId"`
+}
+
+type orderShipped struct {
+	OrderID string `json:"orderId"`
+}
+
+var testDataTypes = map[string]reflect.Type{
+	"order.created": reflect.TypeOf(orderCreated{}),
+	"order.shipped": reflect.TypeOf(orderShipped{}),
+}
+
+func TestReceiverFnWithDataTypes(t *testing.T) {
+	for name, tc := range map[string]struct {
+		fn      interface{}
+		wantErr bool
+	}{
+		"ctx+data in, error out": {
+			fn: func(context.Context, *orderCreated) error { return nil },
+		},
+		"data in, no out": {
+			fn: func(*orderShipped) {},
+		},
+		"ctx+Event in still valid": {
+			fn: func(context.Context, event.Event) error { return nil },
+		},
+		"data as non-ptr in": {
+			fn:      func(context.Context, orderCreated) error { return nil },
+			wantErr: true,
+		},
+		"unregistered data in": {
+			fn:      func(context.Context, *myErr) error { return nil },
+			wantErr: true,
+		},
+		"Event+data in": {
+			fn:      func(event.Event, *orderCreated) error { return nil },
+			wantErr: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := receiverWithDataTypes(tc.fn, testDataTypes)
+			if tc.wantErr && err == nil {
+				t.Errorf("%q failed to catch the issue", name)
+			} else if !tc.wantErr && err != nil {
+				t.Errorf("%q failed: %v", name, err)
+			}
+		})
+	}
+
+	if _, err := receiver(func(context.Context, *orderCreated) error { return nil }); err == nil {
+		t.Error("expected data parameter to be rejected without registered data types")
+	}
+}
+
+func TestReceiverFnInvokeWithDataTypes(t *testing.T) {
+	newEvent := func(eventType string, data string) *event.Event {
+		e := event.New()
+		e.SetType(eventType)
+		_ = e.SetData(event.ApplicationJSON, []byte(data))
+		return &e
+	}
+
+	for name, tc := range map[string]struct {
+		event     *event.Event
+		wantOrder *orderCreated
+		wantErr   string
+	}{
+		"decodes data": {
+			event:     newEvent("order.created", `{"orderId":"42"}`),
+			wantOrder: &orderCreated{OrderID: "42"},
+		},
+		"unknown event type": {
+			event:   newEvent("order.deleted", `{"orderId":"42"}`),
+			wantErr: `no data type registered for event type "order.deleted"`,
+		},
+		"mismatched data type": {
+			event:   newEvent("order.shipped", `{"orderId":"42"}`),
+			wantErr: `event type "order.shipped" is registered with data type client.orderShipped, but the receiver expects client.orderCreated`,
+		},
+		"malformed data": {
+			event:   newEvent("order.created", `{"orderId":`),
+			wantErr: `failed to decode data of event type "order.created" into client.orderCreated`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var gotOrder *orderCreated
+			fn, err := receiverWithDataTypes(func(ctx context.Context, o *orderCreated) error {
+				gotOrder = o
+				return nil
+			}, testDataTypes)
+			if err != nil {
+				t.Fatalf("unexpected error, wanted nil got = %v", err)
+			}
+
+			_, result := fn.invoke(context.TODO(), tc.event)
+
+			if tc.wantErr != "" {
+				if result == nil || !strings.Contains(result.Error(), tc.wantErr) {
+					t.Errorf("expected error containing %q, got = %v", tc.wantErr, result)
+				}
+				if !protocol.IsNACK(result) {
+					t.Errorf("expected a NACK, got = %v", result)
+				}
+				if gotOrder != nil {
+					t.Errorf("receiver fn should not be invoked, got = %v", gotOrder)
+				}
+				return
+			}
+			if result != nil {
+				t.Errorf("unexpected error, wanted nil got = %v", result)
+			}
+			if diff := cmp.Diff(tc.wantOrder, gotOrder); diff != "" {
+				t.Errorf("unexpected data (-want, +got) = %v", diff)
+			}
+		})
+	}
+}
+
 type myErr struct {
 }
 
@@ -0,0 +1,140 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package event
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RedactedValue is the value replacing the data members redacted by Anonymize.
+const RedactedValue = "REDACTED"
+
+// AnonymizeRules specifies how Anonymize scrubs the personal data of an event.
+// The data paths are dot separated member names of the JSON data, e.g.
+// "user.email", where a number selects an element of an array and "*" selects
+// all the members of an object or all the elements of an array, e.g. "users.*.email".
+// The paths not found in the data are ignored.
+type AnonymizeRules struct {
+	// Drop lists the optional attributes (subject, time and dataschema) and
+	// the extensions to remove from the event.
+	Drop []string
+	// RedactData lists the paths of the data values to replace with RedactedValue.
+	RedactData []string
+	// HashData lists the paths of the data values to replace with the hex encoded
+	// SHA-256 of the value, i.e. of the string itself for strings and of the JSON
+	// encoding for the other values, so that they can still be correlated.
+	HashData []string
+	// HashKey, if set, makes HashData use HMAC-SHA256 with this key, which
+	// prevents recovering low-entropy values, e.g. phone numbers, by brute force.
+	HashKey []byte
+}
+
+// Anonymize returns a copy of e scrubbed according to rules, leaving e intact.
+// The data of e must be JSON if rules has any data path.
+func Anonymize(e Event, rules AnonymizeRules) (Event, error) {
+	out := e.Clone()
+	for _, name := range rules.Drop {
+		switch strings.ToLower(name) {
+		case "subject":
+			out.SetSubject("")
+		case "time":
+			out.SetTime(time.Time{})
+		case "dataschema":
+			out.SetDataSchema("")
+		case "id", "source", "specversion", "type", "datacontenttype", "data_base64", "data", "schemaurl", "datacontentencoding":
+			return Event{}, fmt.Errorf("cannot drop the attribute %q", name)
+		default:
+			out.SetExtension(name, nil)
+		}
+	}
+	if len(rules.RedactData) == 0 && len(rules.HashData) == 0 {
+		return out, nil
+	}
+
+	data := out.Data()
+	if len(data) == 0 {
+		return out, nil
+	}
+	if !isJSONMediaType(out.DataMediaType()) {
+		return Event{}, fmt.Errorf("cannot anonymize the data of media type %q, expected JSON", out.DataMediaType())
+	}
+	if out.SpecVersion() != CloudEventsVersionV1 {
+		var err error
+		if data, err = out.legacyConvertData(data); err != nil {
+			return Event{}, err
+		}
+	}
+	var doc interface{}
+	if err := decodeJSONWithNumbers(data, &doc); err != nil {
+		return Event{}, fmt.Errorf("failed to decode the data: %w", err)
+	}
+
+	for _, path := range rules.RedactData {
+		doc = replacePath(doc, strings.Split(path, "."), func(interface{}) interface{} {
+			return RedactedValue
+		})
+	}
+	for _, path := range rules.HashData {
+		doc = replacePath(doc, strings.Split(path, "."), func(v interface{}) interface{} {
+			return hashValue(v, rules.HashKey)
+		})
+	}
+
+	if err := out.SetData(out.DataContentType(), doc); err != nil {
+		return Event{}, err
+	}
+	return out, nil
+}
+
+// replacePath replaces the values of doc at path with the result of fn.
+func replacePath(doc interface{}, path []string, fn func(interface{}) interface{}) interface{} {
+	if len(path) == 0 {
+		return fn(doc)
+	}
+	key, rest := path[0], path[1:]
+	switch v := doc.(type) {
+	case map[string]interface{}:
+		if key == "*" {
+			for k, child := range v {
+				v[k] = replacePath(child, rest, fn)
+			}
+		} else if child, ok := v[key]; ok {
+			v[key] = replacePath(child, rest, fn)
+		}
+	case []interface{}:
+		if key == "*" {
+			for i, child := range v {
+				v[i] = replacePath(child, rest, fn)
+			}
+		} else if i, err := strconv.Atoi(key); err == nil && i >= 0 && i < len(v) {
+			v[i] = replacePath(v[i], rest, fn)
+		}
+	}
+	return doc
+}
+
+func hashValue(v interface{}, key []byte) string {
+	var b []byte
+	if s, ok := v.(string); ok {
+		b = []byte(s)
+	} else {
+		b, _ = json.Marshal(v)
+	}
+	if key != nil {
+		mac := hmac.New(sha256.New, key)
+		mac.Write(b)
+		return hex.EncodeToString(mac.Sum(nil))
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,101 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package event_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+)
+
+func newPIIEvent(t *testing.T) event.Event {
+	e := event.New()
+	e.SetID("123")
+	e.SetSource("/unit/test")
+	e.SetType("user.created")
+	e.SetSubject("jane@example.com")
+	e.SetTime(time.Now())
+	e.SetExtension("clientip", "10.0.0.1")
+	e.SetExtension("tenant", "acme")
+	require.NoError(t, e.SetData(event.ApplicationJSON, map[string]interface{}{
+		"user": map[string]interface{}{"email": "jane@example.com", "phone": "+15550100", "age": 42},
+		"devices": []interface{}{
+			map[string]interface{}{"serial": "A1"},
+			map[string]interface{}{"serial": "B2"},
+		},
+	}))
+	return e
+}
+
+func TestAnonymize(t *testing.T) {
+	e := newPIIEvent(t)
+	original := e.Clone()
+
+	got, err := event.Anonymize(e, event.AnonymizeRules{
+		Drop:       []string{"subject", "clientip", "missing"},
+		RedactData: []string{"user.phone", "devices.*.serial", "user.missing"},
+		HashData:   []string{"user.email"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, original, e, "the original event must be left intact")
+
+	require.Empty(t, got.Subject())
+	require.Equal(t, map[string]interface{}{"tenant": "acme"}, got.Extensions())
+	require.Equal(t, e.Time(), got.Time())
+
+	sum := sha256.Sum256([]byte("jane@example.com"))
+	require.JSONEq(t, `{
+		"user": {"email": "`+hex.EncodeToString(sum[:])+`", "phone": "REDACTED", "age": 42},
+		"devices": [{"serial": "REDACTED"}, {"serial": "REDACTED"}]
+	}`, string(got.Data()))
+}
+
+func TestAnonymize_HashKey(t *testing.T) {
+	e := newPIIEvent(t)
+	rules := event.AnonymizeRules{HashData: []string{"user.email", "devices.0.serial"}}
+
+	unkeyed, err := event.Anonymize(e, rules)
+	require.NoError(t, err)
+	rules.HashKey = []byte("secret")
+	keyed, err := event.Anonymize(e, rules)
+	require.NoError(t, err)
+	again, err := event.Anonymize(e, rules)
+	require.NoError(t, err)
+
+	require.NotEqual(t, string(unkeyed.Data()), string(keyed.Data()))
+	require.Equal(t, string(keyed.Data()), string(again.Data()))
+}
+
+func TestAnonymize_Errors(t *testing.T) {
+	e := newPIIEvent(t)
+	_, err := event.Anonymize(e, event.AnonymizeRules{Drop: []string{"id"}})
+	require.Error(t, err)
+
+	require.NoError(t, e.SetData(event.TextPlain, "jane@example.com"))
+	_, err = event.Anonymize(e, event.AnonymizeRules{RedactData: []string{"email"}})
+	require.Error(t, err)
+
+	// Text data can be kept as is
+	got, err := event.Anonymize(e, event.AnonymizeRules{Drop: []string{"subject"}})
+	require.NoError(t, err)
+	require.Equal(t, e.Data(), got.Data())
+}
+
+func TestAnonymize_TrailingJSONData(t *testing.T) {
+	e := newPIIEvent(t)
+	e.DataEncoded = []byte(`{"email":"jane@example.com"} garbage`)
+	_, err := event.Anonymize(e, event.AnonymizeRules{RedactData: []string{"email"}})
+	require.Error(t, err)
+
+	e.DataEncoded = []byte(`{"email":"jane@example.com"}{}`)
+	_, err = event.Anonymize(e, event.AnonymizeRules{RedactData: []string{"email"}})
+	require.Error(t, err)
+}
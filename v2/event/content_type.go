@@ -12,6 +12,7 @@ const (
 	ApplicationXML                  = "application/xml"
 	ApplicationCloudEventsJSON      = "application/cloudevents+json"
 	ApplicationCloudEventsBatchJSON = "application/cloudevents-batch+json"
+	ApplicationMergePatchJSON       = "application/merge-patch+json"
 )
 
 // StringOfApplicationJSON returns a string pointer to "application/json"
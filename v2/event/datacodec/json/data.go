@@ -6,6 +6,7 @@
 package json
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -15,6 +16,8 @@ import (
 // Decode takes `in` as []byte.
 // If Event sent the payload as base64, Decoder assumes that `in` is the
 // decoded base64 byte array.
+// Numbers decoded into an interface{} are kept as json.Number rather than
+// float64, so that large integers are not rounded.
 func Decode(ctx context.Context, in []byte, out interface{}) error {
 	if in == nil {
 		return nil
@@ -23,12 +26,22 @@ func Decode(ctx context.Context, in []byte, out interface{}) error {
 		return fmt.Errorf("out is nil")
 	}
 
-	if err := json.Unmarshal(in, out); err != nil {
+	if err := unmarshal(in, out); err != nil {
 		return fmt.Errorf("[json] found bytes \"%s\", but failed to unmarshal: %s", string(in), err.Error())
 	}
 	return nil
 }
 
+func unmarshal(in []byte, out interface{}) error {
+	if !json.Valid(in) {
+		// Let json.Unmarshal describe why the input is not valid.
+		return json.Unmarshal(in, out)
+	}
+	dec := json.NewDecoder(bytes.NewReader(in))
+	dec.UseNumber()
+	return dec.Decode(out)
+}
+
 // Encode attempts to json.Marshal `in` into bytes. Encode will inspect `in`
 // and returns `in` unmodified if it is detected that `in` is already a []byte;
 // Or json.Marshal errors.
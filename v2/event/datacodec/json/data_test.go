@@ -229,6 +229,20 @@ func TestCodecEncode(t *testing.T) {
 	}
 }
 
+func TestCodecDecodeUseNumber(t *testing.T) {
+	var got map[string]interface{}
+	if err := cej.Decode(context.TODO(), []byte(`{"id":9007199254740993,"nested":{"n":1.5}}`), &got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]interface{}{
+		"id":     json.Number("9007199254740993"),
+		"nested": map[string]interface{}{"n": json.Number("1.5")},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected data (-want, +got) = %v", diff)
+	}
+}
+
 func cmpErrors(want string, err error) string {
 	if want != "" || err != nil {
 		var gotErr string
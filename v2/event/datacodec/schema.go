@@ -0,0 +1,109 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package datacodec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Schema is a data schema resolved by a SchemaResolver.
+type Schema struct {
+	// ID is the identifier of the schema in the registry, if any.
+	ID int
+	// Subject is the subject the schema is registered under, if known.
+	Subject string
+	// Version is the version of the schema within its subject, if known.
+	Version int
+	// Type is the schema type, e.g. AVRO, PROTOBUF or JSON.
+	Type string
+	// Schema is the schema definition.
+	Schema string
+}
+
+// SchemaResolver resolves the schema referenced by the dataschema attribute
+// of an event, so that the decoders of schema based formats such as Avro or
+// Protobuf, registered with AddSchemaDecoder, can decode the event data.
+type SchemaResolver interface {
+	Resolve(ctx context.Context, dataSchema string) (*Schema, error)
+}
+
+// ErrNoSchemaResolver is returned by ResolveSchema when ctx carries no SchemaResolver.
+var ErrNoSchemaResolver = errors.New("no schema resolver in context")
+
+// ErrNoDataSchema is returned by ResolveSchema when ctx carries no dataschema.
+var ErrNoDataSchema = errors.New("no dataschema in context")
+
+// Opaque key type used to store the schema resolver
+type schemaResolverKeyType struct{}
+
+var schemaResolverKey = schemaResolverKeyType{}
+
+// WithSchemaResolver returns back a new context with the given schema resolver.
+func WithSchemaResolver(ctx context.Context, r SchemaResolver) context.Context {
+	return context.WithValue(ctx, schemaResolverKey, r)
+}
+
+// SchemaResolverFrom looks in the given context and returns the schema resolver if found, otherwise nil.
+func SchemaResolverFrom(ctx context.Context) SchemaResolver {
+	if r, ok := ctx.Value(schemaResolverKey).(SchemaResolver); ok {
+		return r
+	}
+	return nil
+}
+
+// Opaque key type used to store the dataschema
+type dataSchemaKeyType struct{}
+
+var dataSchemaKey = dataSchemaKeyType{}
+
+// WithDataSchema returns back a new context with the dataschema of the data to decode.
+func WithDataSchema(ctx context.Context, dataSchema string) context.Context {
+	return context.WithValue(ctx, dataSchemaKey, dataSchema)
+}
+
+// DataSchemaFrom looks in the given context and returns the dataschema if found, otherwise "".
+func DataSchemaFrom(ctx context.Context) string {
+	if s, ok := ctx.Value(dataSchemaKey).(string); ok {
+		return s
+	}
+	return ""
+}
+
+// ResolveSchema resolves the dataschema found in the given context with the
+// schema resolver found in the given context. The decoders registered with
+// AddSchemaDecoder are given the schema it returns.
+func ResolveSchema(ctx context.Context) (*Schema, error) {
+	r := SchemaResolverFrom(ctx)
+	if r == nil {
+		return nil, ErrNoSchemaResolver
+	}
+	dataSchema := DataSchemaFrom(ctx)
+	if dataSchema == "" {
+		return nil, ErrNoDataSchema
+	}
+	return r.Resolve(ctx, dataSchema)
+}
+
+// SchemaDecoder is the expected function signature for decoding `in` to `out`
+// with the schema referenced by the dataschema of the data.
+type SchemaDecoder func(ctx context.Context, schema *Schema, in []byte, out interface{}) error
+
+// AddSchemaDecoder registers a decoder for a given content type of a schema
+// based format, e.g. Avro. When decoding data of this content type, Decode
+// resolves the schema with ResolveSchema and passes it to fn, failing if the
+// schema can't be resolved. See event.DataAsContext, which sets the dataschema
+// of the event in the context, and client.WithSchemaResolver.
+func AddSchemaDecoder(contentType string, fn SchemaDecoder) {
+	AddDecoder(contentType, func(ctx context.Context, in []byte, out interface{}) error {
+		schema, err := ResolveSchema(ctx)
+		if err != nil {
+			return fmt.Errorf("cannot resolve the data schema: %w", err)
+		}
+		return fn(ctx, schema, in, out)
+	})
+}
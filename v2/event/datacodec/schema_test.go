@@ -0,0 +1,95 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package datacodec_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/cloudevents/sdk-go/v2/event/datacodec"
+)
+
+type fakeSchemaResolver map[string]*datacodec.Schema
+
+func (r fakeSchemaResolver) Resolve(_ context.Context, dataSchema string) (*datacodec.Schema, error) {
+	if s, ok := r[dataSchema]; ok {
+		return s, nil
+	}
+	return nil, errors.New("schema not found")
+}
+
+func TestResolveSchema(t *testing.T) {
+	schema := &datacodec.Schema{ID: 1, Type: "AVRO", Schema: `"string"`}
+	resolver := fakeSchemaResolver{"sr://schemas/ids/1": schema}
+
+	testCases := map[string]struct {
+		ctx     context.Context
+		want    *datacodec.Schema
+		wantErr error
+	}{
+		"resolved": {
+			ctx:  datacodec.WithDataSchema(datacodec.WithSchemaResolver(context.Background(), resolver), "sr://schemas/ids/1"),
+			want: schema,
+		},
+		"no resolver": {
+			ctx:     datacodec.WithDataSchema(context.Background(), "sr://schemas/ids/1"),
+			wantErr: datacodec.ErrNoSchemaResolver,
+		},
+		"no dataschema": {
+			ctx:     datacodec.WithSchemaResolver(context.Background(), resolver),
+			wantErr: datacodec.ErrNoDataSchema,
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			got, err := datacodec.ResolveSchema(tc.ctx)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("unexpected error, want %v got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected schema (-want, +got) = %v", diff)
+			}
+		})
+	}
+}
+
+func TestAddSchemaDecoder(t *testing.T) {
+	const contentType = "application/x-unit-test-schema"
+	schema := &datacodec.Schema{ID: 1, Type: "AVRO", Schema: `"string"`}
+	resolver := fakeSchemaResolver{"sr://schemas/ids/1": schema}
+
+	var got *datacodec.Schema
+	datacodec.AddSchemaDecoder(contentType, func(ctx context.Context, s *datacodec.Schema, in []byte, out interface{}) error {
+		got = s
+		return nil
+	})
+
+	ctx := datacodec.WithDataSchema(datacodec.WithSchemaResolver(context.Background(), resolver), "sr://schemas/ids/1")
+	if err := datacodec.Decode(ctx, contentType, []byte("data"), nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(schema, got); diff != "" {
+		t.Errorf("unexpected schema (-want, +got) = %v", diff)
+	}
+
+	got = nil
+	err := datacodec.Decode(datacodec.WithDataSchema(context.Background(), "sr://schemas/ids/1"), contentType, []byte("data"), nil)
+	if !errors.Is(err, datacodec.ErrNoSchemaResolver) {
+		t.Errorf("unexpected error, want %v got %v", datacodec.ErrNoSchemaResolver, err)
+	}
+	if got != nil {
+		t.Errorf("unexpected decoder call without a schema")
+	}
+}
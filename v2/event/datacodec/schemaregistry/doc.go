@@ -0,0 +1,10 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+/*
+Package schemaregistry implements a datacodec.SchemaResolver backed by a
+Confluent compatible schema registry.
+*/
+package schemaregistry
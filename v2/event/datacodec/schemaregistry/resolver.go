@@ -0,0 +1,191 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package schemaregistry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cloudevents/sdk-go/v2/event/datacodec"
+)
+
+// Scheme is the URI scheme of the dataschema values referencing a schema
+// relative to the registry, e.g. sr://schemas/ids/42 or
+// sr://subjects/orders-value/versions/3.
+const Scheme = "sr"
+
+const defaultSchemaType = "AVRO"
+
+// Resolver resolves dataschema values referencing schemas stored in a schema
+// registry, caching the resolved schemas.
+// The supported dataschema values are URIs with the "sr" scheme or URLs
+// starting with the registry base URL, having one of the paths:
+//
+//	schemas/ids/{id}
+//	subjects/{subject}/versions/{version}
+//
+// Schemas referenced by the "latest" version are not cached.
+type Resolver struct {
+	baseURL *url.URL
+	client  *http.Client
+
+	mu    sync.RWMutex
+	cache map[string]*datacodec.Schema
+}
+
+var _ datacodec.SchemaResolver = (*Resolver)(nil)
+
+// Option is the function signature required to be considered a schemaregistry.Option.
+type Option func(*Resolver) error
+
+// WithHTTPClient sets the http client used to query the registry.
+// Default is http.DefaultClient.
+func WithHTTPClient(client *http.Client) Option {
+	return func(r *Resolver) error {
+		if client == nil {
+			return fmt.Errorf("http client option can not set nil client")
+		}
+		r.client = client
+		return nil
+	}
+}
+
+// New creates a Resolver querying the schema registry at baseURL.
+func New(baseURL string, opts ...Option) (*Resolver, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema registry url %q: %w", baseURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid schema registry url %q: expected an http or https url", baseURL)
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/")
+
+	r := &Resolver{
+		baseURL: u,
+		client:  http.DefaultClient,
+		cache:   make(map[string]*datacodec.Schema),
+	}
+	for _, opt := range opts {
+		if err := opt(r); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Resolve implements datacodec.SchemaResolver.
+func (r *Resolver) Resolve(ctx context.Context, dataSchema string) (*datacodec.Schema, error) {
+	path, err := r.registryPath(dataSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	schema, ok := r.cache[path]
+	r.mu.RUnlock()
+	if ok {
+		return schema, nil
+	}
+
+	schema, err = r.fetch(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(path, "/versions/latest") {
+		r.mu.Lock()
+		r.cache[path] = schema
+		r.mu.Unlock()
+	}
+	return schema, nil
+}
+
+// registryPath returns the registry path, relative to the base url, of the
+// schema referenced by dataSchema.
+func (r *Resolver) registryPath(dataSchema string) (string, error) {
+	var path string
+	switch {
+	case strings.HasPrefix(dataSchema, Scheme+":"):
+		path = strings.TrimPrefix(dataSchema, Scheme+":")
+	case strings.HasPrefix(dataSchema, r.baseURL.String()+"/"):
+		path = strings.TrimPrefix(dataSchema, r.baseURL.String())
+	default:
+		return "", fmt.Errorf("unsupported dataschema %q: expected a %s: uri or an url of the schema registry %s", dataSchema, Scheme, r.baseURL)
+	}
+	path = strings.Trim(path, "/")
+
+	segments := strings.Split(path, "/")
+	switch {
+	case len(segments) == 3 && segments[0] == "schemas" && segments[1] == "ids":
+		if _, err := strconv.Atoi(segments[2]); err != nil {
+			return "", fmt.Errorf("unsupported dataschema %q: invalid schema id %q", dataSchema, segments[2])
+		}
+	case len(segments) == 4 && segments[0] == "subjects" && segments[1] != "" && segments[2] == "versions":
+		if _, err := strconv.Atoi(segments[3]); err != nil && segments[3] != "latest" {
+			return "", fmt.Errorf("unsupported dataschema %q: invalid schema version %q", dataSchema, segments[3])
+		}
+	default:
+		return "", fmt.Errorf("unsupported dataschema %q: expected a schemas/ids/{id} or subjects/{subject}/versions/{version} path", dataSchema)
+	}
+	return path, nil
+}
+
+type schemaResponse struct {
+	Subject    string `json:"subject"`
+	Version    int    `json:"version"`
+	ID         int    `json:"id"`
+	SchemaType string `json:"schemaType"`
+	Schema     string `json:"schema"`
+}
+
+func (r *Resolver) fetch(ctx context.Context, path string) (*datacodec.Schema, error) {
+	u := *r.baseURL
+	u.Path = u.Path + "/" + path
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.schemaregistry.v1+json, application/json")
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch schema %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("failed to fetch schema %s: unexpected status %d: %s", path, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var sr schemaResponse
+	if err := json.NewDecoder(resp.Body).Decode(&sr); err != nil {
+		return nil, fmt.Errorf("failed to decode schema %s: %w", path, err)
+	}
+
+	schema := &datacodec.Schema{
+		ID:      sr.ID,
+		Subject: sr.Subject,
+		Version: sr.Version,
+		Type:    sr.SchemaType,
+		Schema:  sr.Schema,
+	}
+	if schema.Type == "" {
+		schema.Type = defaultSchemaType
+	}
+	if segments := strings.Split(path, "/"); segments[0] == "schemas" {
+		// the ids endpoint does not return the id
+		schema.ID, _ = strconv.Atoi(segments[2])
+	}
+	return schema, nil
+}
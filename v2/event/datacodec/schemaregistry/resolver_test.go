@@ -0,0 +1,127 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package schemaregistry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/event/datacodec"
+)
+
+func newTestRegistry(t *testing.T) (*httptest.Server, *int32) {
+	var hits int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/registry/schemas/ids/42", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		_, _ = w.Write([]byte(`{"schema":"{\"type\":\"string\"}"}`))
+	})
+	mux.HandleFunc("/registry/subjects/orders-value/versions/3", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		_, _ = w.Write([]byte(`{"subject":"orders-value","version":3,"id":7,"schemaType":"PROTOBUF","schema":"syntax = \"proto3\";"}`))
+	})
+	mux.HandleFunc("/registry/subjects/orders-value/versions/latest", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		_, _ = w.Write([]byte(`{"subject":"orders-value","version":4,"id":8,"schemaType":"PROTOBUF","schema":"syntax = \"proto3\";"}`))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv, &hits
+}
+
+func TestResolver_Resolve(t *testing.T) {
+	srv, _ := newTestRegistry(t)
+	r, err := New(srv.URL + "/registry/")
+	require.NoError(t, err)
+
+	for name, tc := range map[string]struct {
+		dataSchema string
+		want       *datacodec.Schema
+		wantErr    bool
+	}{
+		"sr id": {
+			dataSchema: "sr://schemas/ids/42",
+			want:       &datacodec.Schema{ID: 42, Type: "AVRO", Schema: `{"type":"string"}`},
+		},
+		"sr subject version": {
+			dataSchema: "sr:subjects/orders-value/versions/3",
+			want:       &datacodec.Schema{ID: 7, Subject: "orders-value", Version: 3, Type: "PROTOBUF", Schema: `syntax = "proto3";`},
+		},
+		"registry url": {
+			dataSchema: srv.URL + "/registry/schemas/ids/42",
+			want:       &datacodec.Schema{ID: 42, Type: "AVRO", Schema: `{"type":"string"}`},
+		},
+		"other url": {
+			dataSchema: "http://example.com/schemas/ids/42",
+			wantErr:    true,
+		},
+		"invalid id": {
+			dataSchema: "sr://schemas/ids/abc",
+			wantErr:    true,
+		},
+		"invalid path": {
+			dataSchema: "sr://subjects/orders-value",
+			wantErr:    true,
+		},
+		"not found": {
+			dataSchema: "sr://schemas/ids/1",
+			wantErr:    true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := r.Resolve(context.Background(), tc.dataSchema)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestResolver_Cache(t *testing.T) {
+	srv, hits := newTestRegistry(t)
+	r, err := New(srv.URL + "/registry")
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		_, err := r.Resolve(context.Background(), "sr://schemas/ids/42")
+		require.NoError(t, err)
+	}
+	require.Equal(t, int32(1), atomic.LoadInt32(hits))
+
+	for i := 0; i < 2; i++ {
+		_, err := r.Resolve(context.Background(), "sr://subjects/orders-value/versions/latest")
+		require.NoError(t, err)
+	}
+	require.Equal(t, int32(3), atomic.LoadInt32(hits))
+}
+
+func TestResolver_ResolveFromContext(t *testing.T) {
+	srv, _ := newTestRegistry(t)
+	r, err := New(srv.URL + "/registry")
+	require.NoError(t, err)
+
+	ctx := datacodec.WithSchemaResolver(context.Background(), r)
+	ctx = datacodec.WithDataSchema(ctx, "sr://schemas/ids/42")
+	got, err := datacodec.ResolveSchema(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 42, got.ID)
+}
+
+func TestNew_InvalidURL(t *testing.T) {
+	_, err := New("sr://schemas")
+	require.Error(t, err)
+
+	_, err = New("http://localhost", WithHTTPClient(nil))
+	require.Error(t, err)
+}
@@ -0,0 +1,125 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package event
+
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultErrorEventType is the type of the events created by NewErrorEvent,
+	// unless WithErrorEventType is provided.
+	DefaultErrorEventType = "io.cloudevents.error"
+
+	// ErrorTypeExtension is the extension holding the Go type of the error
+	// of the events created by NewErrorEvent.
+	ErrorTypeExtension = "errortype"
+	// ErrorCodeExtension is the extension holding the error code of the events
+	// created by NewErrorEvent, if any.
+	ErrorCodeExtension = "errorcode"
+)
+
+// ErrorCoder is implemented by the errors carrying an error code, which
+// NewErrorEvent sets in the errorcode extension.
+type ErrorCoder interface {
+	ErrorCode() string
+}
+
+// ErrorEventData is the data of the events created by NewErrorEvent.
+type ErrorEventData struct {
+	// Message is the error message.
+	Message string `json:"message"`
+	// Stack is the stack trace of the goroutine which created the event, if requested with WithErrorStack.
+	Stack string `json:"stack,omitempty"`
+	// Cause is the event which caused the error, if provided with WithErrorCause.
+	Cause *Event `json:"cause,omitempty"`
+}
+
+type errorEventOptions struct {
+	eventType string
+	code      string
+	stack     bool
+	cause     *Event
+}
+
+// ErrorEventOption configures the events created by NewErrorEvent.
+type ErrorEventOption func(*errorEventOptions)
+
+// WithErrorEventType sets the type of the error event. Default is DefaultErrorEventType.
+func WithErrorEventType(eventType string) ErrorEventOption {
+	return func(o *errorEventOptions) {
+		o.eventType = eventType
+	}
+}
+
+// WithErrorCode sets the errorcode extension of the error event, overriding
+// the code of the error if it implements ErrorCoder.
+func WithErrorCode(code string) ErrorEventOption {
+	return func(o *errorEventOptions) {
+		o.code = code
+	}
+}
+
+// WithErrorStack adds the stack trace of the calling goroutine to the error event data.
+func WithErrorStack() ErrorEventOption {
+	return func(o *errorEventOptions) {
+		o.stack = true
+	}
+}
+
+// WithErrorCause adds the event which caused the error to the error event data,
+// and sets the error event subject to its id.
+func WithErrorCause(cause Event) ErrorEventOption {
+	return func(o *errorEventOptions) {
+		o.cause = &cause
+	}
+}
+
+// NewErrorEvent creates an event reporting err, with the given source.
+// The event data is an ErrorEventData encoded as JSON, and the event has the
+// errortype extension set to the Go type of err and, if err or one of the errors
+// it wraps implements ErrorCoder, the errorcode extension set to its code.
+// The event id is a new UUID and its time is the current time.
+func NewErrorEvent(source string, err error, opts ...ErrorEventOption) (Event, error) {
+	if err == nil {
+		return Event{}, errors.New("cannot create an error event from a nil error")
+	}
+	o := errorEventOptions{eventType: DefaultErrorEventType}
+	var coder ErrorCoder
+	if errors.As(err, &coder) {
+		o.code = coder.ErrorCode()
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	e := New()
+	e.SetID(uuid.New().String())
+	e.SetTime(time.Now())
+	e.SetSource(source)
+	e.SetType(o.eventType)
+	e.SetExtension(ErrorTypeExtension, fmt.Sprintf("%T", err))
+	if o.code != "" {
+		e.SetExtension(ErrorCodeExtension, o.code)
+	}
+
+	data := ErrorEventData{Message: err.Error(), Cause: o.cause}
+	if o.stack {
+		data.Stack = string(debug.Stack())
+	}
+	if o.cause != nil && o.cause.Context != nil {
+		e.SetSubject(o.cause.ID())
+	}
+	if err := e.SetData(ApplicationJSON, data); err != nil {
+		return Event{}, err
+	}
+	return e, e.Validate()
+}
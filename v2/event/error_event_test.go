@@ -0,0 +1,79 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package event_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+)
+
+type codedError struct{ code string }
+
+func (e *codedError) Error() string     { return "coded error" }
+func (e *codedError) ErrorCode() string { return e.code }
+
+func TestNewErrorEvent(t *testing.T) {
+	e, err := event.NewErrorEvent("/my/source", errors.New("boom"))
+	require.NoError(t, err)
+	require.NotEmpty(t, e.ID())
+	require.False(t, e.Time().IsZero())
+	require.Equal(t, "/my/source", e.Source())
+	require.Equal(t, event.DefaultErrorEventType, e.Type())
+	require.Equal(t, event.ApplicationJSON, e.DataContentType())
+	require.Equal(t, "*errors.errorString", e.Extensions()[event.ErrorTypeExtension])
+	require.NotContains(t, e.Extensions(), event.ErrorCodeExtension)
+
+	var data event.ErrorEventData
+	require.NoError(t, e.DataAs(&data))
+	require.Equal(t, event.ErrorEventData{Message: "boom"}, data)
+}
+
+func TestNewErrorEvent_ErrorCode(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", &codedError{code: "E42"})
+
+	e, nerr := event.NewErrorEvent("/my/source", err)
+	require.NoError(t, nerr)
+	require.Equal(t, "E42", e.Extensions()[event.ErrorCodeExtension])
+
+	e, nerr = event.NewErrorEvent("/my/source", err, event.WithErrorCode("E43"))
+	require.NoError(t, nerr)
+	require.Equal(t, "E43", e.Extensions()[event.ErrorCodeExtension])
+}
+
+func TestNewErrorEvent_Options(t *testing.T) {
+	cause := event.New()
+	cause.SetID("cause-id")
+	cause.SetSource("/cause")
+	cause.SetType("cause.type")
+	require.NoError(t, cause.SetData(event.ApplicationJSON, map[string]string{"hello": "world"}))
+
+	e, err := event.NewErrorEvent("/my/source", errors.New("boom"),
+		event.WithErrorEventType("io.myorg.error"),
+		event.WithErrorStack(),
+		event.WithErrorCause(cause),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "io.myorg.error", e.Type())
+	require.Equal(t, "cause-id", e.Subject())
+
+	var data event.ErrorEventData
+	require.NoError(t, e.DataAs(&data))
+	require.Equal(t, "boom", data.Message)
+	require.Contains(t, data.Stack, "NewErrorEvent")
+	require.NotNil(t, data.Cause)
+	require.Equal(t, "cause-id", data.Cause.ID())
+	require.Equal(t, cause.Data(), data.Cause.Data())
+}
+
+func TestNewErrorEvent_NilError(t *testing.T) {
+	_, err := event.NewErrorEvent("/my/source", nil)
+	require.Error(t, err)
+}
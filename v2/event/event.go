@@ -39,6 +39,38 @@ func (e *Event) fieldOK(field string) {
 	}
 }
 
+// Reset clears the event so that it can be reused, e.g. from a sync.Pool, to
+// decode another event with ReadJson or json.Unmarshal without allocating a new
+// event context and extensions map.
+// The cleared context and extensions map are kept and reused by the decoding
+// when the decoded spec version matches, hence a reset event must not be copied,
+// and its previous context must not be referenced anymore.
+func (e *Event) Reset() {
+	switch ec := e.Context.(type) {
+	case *EventContextV1:
+		*ec = EventContextV1{Extensions: clearExtensions(ec.Extensions)}
+	case *EventContextV03:
+		*ec = EventContextV03{Extensions: clearExtensions(ec.Extensions)}
+	default:
+		e.Context = nil
+	}
+	e.DataEncoded = nil
+	e.DataBase64 = false
+	e.FieldErrors = nil
+}
+
+// clearExtensions deletes all the entries of ext, allocating it if nil.
+// The returned empty map marks the context as reusable.
+func clearExtensions(ext map[string]interface{}) map[string]interface{} {
+	if ext == nil {
+		return make(map[string]interface{})
+	}
+	for k := range ext {
+		delete(ext, k)
+	}
+	return ext
+}
+
 // New returns a new Event, an optional version can be passed to change the
 // default spec version from 1.0 to the provided version.
 func New(version ...string) Event {
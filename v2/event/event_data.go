@@ -10,6 +10,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cloudevents/sdk-go/v2/event/datacodec"
 )
@@ -44,6 +45,16 @@ func (e *Event) SetData(contentType string, obj interface{}) error {
 
 // Deprecated: Delete when we do not have to support Spec v0.3.
 func (e *Event) legacySetData(obj interface{}) error {
+	if b, ok := obj.([]byte); ok && !isTextMediaType(e.DataMediaType()) {
+		// The raw data of a non textual media type, like the binary messages
+		// read with ToEvent: it's base64 encoded in the JSON format.
+		if err := e.Context.DeprecatedSetDataContentEncoding(Base64); err != nil {
+			return err
+		}
+		e.DataEncoded = b
+		e.DataBase64 = true
+		return nil
+	}
 	data, err := datacodec.Encode(context.Background(), e.DataMediaType(), obj)
 	if err != nil {
 		return err
@@ -68,6 +79,14 @@ const (
 	quotes = `"'`
 )
 
+// isTextMediaType returns whether the data of mediaType is text, which the JSON
+// format can hold without encoding it as base64.
+func isTextMediaType(mediaType string) bool {
+	return mediaType == "" || strings.HasPrefix(mediaType, "text/") ||
+		mediaType == ApplicationJSON || strings.HasSuffix(mediaType, "+json") ||
+		mediaType == ApplicationXML || strings.HasSuffix(mediaType, "+xml")
+}
+
 func (e Event) Data() []byte {
 	return e.DataEncoded
 }
@@ -75,6 +94,14 @@ func (e Event) Data() []byte {
 // DataAs attempts to populate the provided data object with the event payload.
 // obj should be a pointer type.
 func (e Event) DataAs(obj interface{}) error {
+	return e.DataAsContext(context.Background(), obj)
+}
+
+// DataAsContext is like DataAs, but passes ctx to the data decoder, together
+// with the event dataschema (see datacodec.DataSchemaFrom). The decoders
+// registered with datacodec.AddSchemaDecoder are given the data schema,
+// resolved by the datacodec.SchemaResolver carried by ctx.
+func (e Event) DataAsContext(ctx context.Context, obj interface{}) error {
 	data := e.Data()
 
 	if len(data) == 0 {
@@ -89,7 +116,38 @@ func (e Event) DataAs(obj interface{}) error {
 		}
 	}
 
-	return datacodec.Decode(context.Background(), e.DataMediaType(), data, obj)
+	if err := datacodec.Decode(datacodec.WithDataSchema(ctx, e.DataSchema()), e.DataMediaType(), data, obj); err != nil {
+		return &DataDecodeError{rawData: data, targetType: fmt.Sprintf("%T", obj), err: err}
+	}
+	return nil
+}
+
+// DataDecodeError is returned by DataAs and DataAsContext when the event data
+// cannot be decoded into the provided object. It retains the data which failed
+// to decode, e.g. for logging purposes.
+type DataDecodeError struct {
+	rawData    []byte
+	targetType string
+	err        error
+}
+
+func (e *DataDecodeError) Error() string {
+	return fmt.Sprintf("failed to decode data into %s: %v", e.targetType, e.err)
+}
+
+// Unwrap returns the decoder error.
+func (e *DataDecodeError) Unwrap() error {
+	return e.err
+}
+
+// RawData returns the data which failed to decode.
+func (e *DataDecodeError) RawData() []byte {
+	return e.rawData
+}
+
+// TargetType returns the name of the type of the object the data failed to decode into.
+func (e *DataDecodeError) TargetType() string {
+	return e.targetType
 }
 
 func (e Event) legacyConvertData(data []byte) ([]byte, error) {
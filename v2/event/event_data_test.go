@@ -7,6 +7,9 @@ package event_test
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -313,3 +316,134 @@ func mustEncodeWithDataCodec(tb testing.TB, ct string, in interface{}) []byte {
 	require.NoError(tb, err)
 	return data
 }
+
+func TestEventDataAsContext_DataSchema(t *testing.T) {
+	const contentType = "application/x-unit-test-dataschema"
+	var got string
+	datacodec.AddDecoder(contentType, func(ctx context.Context, in []byte, out interface{}) error {
+		got = datacodec.DataSchemaFrom(ctx)
+		return nil
+	})
+
+	e := event.New()
+	e.SetDataSchema("sr://schemas/ids/1")
+	require.NoError(t, e.SetData(contentType, []byte("data")))
+
+	var out []byte
+	require.NoError(t, e.DataAsContext(context.Background(), &out))
+	require.Equal(t, "sr://schemas/ids/1", got)
+}
+
+type unitTestSchemaResolver map[string]*datacodec.Schema
+
+func (r unitTestSchemaResolver) Resolve(_ context.Context, dataSchema string) (*datacodec.Schema, error) {
+	if s, ok := r[dataSchema]; ok {
+		return s, nil
+	}
+	return nil, fmt.Errorf("schema %q not found", dataSchema)
+}
+
+func TestEventDataAsContext_SchemaDecoder(t *testing.T) {
+	const contentType = "application/x-unit-test-schema-decoder"
+	schema := &datacodec.Schema{ID: 1, Type: "AVRO", Schema: `"string"`}
+	datacodec.AddSchemaDecoder(contentType, func(ctx context.Context, s *datacodec.Schema, in []byte, out interface{}) error {
+		*(out.(*string)) = s.Type + ":" + string(in)
+		return nil
+	})
+	ctx := datacodec.WithSchemaResolver(context.Background(), unitTestSchemaResolver{"sr://schemas/ids/1": schema})
+
+	e := event.New()
+	e.SetDataSchema("sr://schemas/ids/1")
+	require.NoError(t, e.SetData(contentType, []byte("data")))
+
+	var out string
+	require.NoError(t, e.DataAsContext(ctx, &out))
+	require.Equal(t, "AVRO:data", out)
+
+	e.SetDataSchema("sr://schemas/ids/2")
+	err := e.DataAsContext(ctx, &out)
+	var decodeErr *event.DataDecodeError
+	require.ErrorAs(t, err, &decodeErr)
+	require.Contains(t, err.Error(), "cannot resolve the data schema")
+}
+
+func TestEventDataAs_DataDecodeError(t *testing.T) {
+	type target struct {
+		Count int `json:"count"`
+	}
+
+	e := event.New()
+	require.NoError(t, e.SetData(event.ApplicationJSON, []byte(`{"count":"not a number"}`)))
+
+	var out target
+	err := e.DataAs(&out)
+	require.Error(t, err)
+
+	var decodeErr *event.DataDecodeError
+	require.ErrorAs(t, err, &decodeErr)
+	require.Equal(t, []byte(`{"count":"not a number"}`), decodeErr.RawData())
+	require.Equal(t, "*event_test.target", decodeErr.TargetType())
+	require.NotNil(t, errors.Unwrap(decodeErr))
+	require.Contains(t, err.Error(), "*event_test.target")
+}
+
+func TestEventDataAs_SetFrom(t *testing.T) {
+	type payload struct {
+		Hello string `json:"hello"`
+		Count int    `json:"count"`
+	}
+	want := payload{Hello: "world", Count: 2}
+
+	for name, set := range map[string]interface{}{
+		"bytes":  []byte(`{"hello":"world","count":2}`),
+		"map":    map[string]interface{}{"hello": "world", "count": 2},
+		"struct": want,
+	} {
+		t.Run(name, func(t *testing.T) {
+			e := event.New()
+			require.NoError(t, e.SetData(event.ApplicationJSON, set))
+
+			var got payload
+			require.NoError(t, e.DataAs(&got))
+			require.Equal(t, want, got)
+
+			var m map[string]interface{}
+			require.NoError(t, e.DataAs(&m))
+			require.Equal(t, map[string]interface{}{"hello": "world", "count": json.Number("2")}, m)
+		})
+	}
+
+	t.Run("unsupported content type", func(t *testing.T) {
+		e := event.New()
+		require.NoError(t, e.SetData("application/x-unsupported", []byte(`{"hello":"world"}`)))
+
+		var got payload
+		err := e.DataAs(&got)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "application/x-unsupported")
+	})
+}
+
+func TestEventData_RegisteredCodec(t *testing.T) {
+	const contentType = "application/x-unit-test"
+	var encoded, decoded int
+	datacodec.AddEncoder(contentType, func(ctx context.Context, in interface{}) ([]byte, error) {
+		encoded++
+		return []byte("encoded:" + in.(string)), nil
+	})
+	datacodec.AddDecoder(contentType, func(ctx context.Context, in []byte, out interface{}) error {
+		decoded++
+		*(out.(*string)) = strings.TrimPrefix(string(in), "encoded:")
+		return nil
+	})
+
+	e := event.New()
+	require.NoError(t, e.SetData(contentType, "hello"))
+	require.Equal(t, 1, encoded)
+	require.Equal(t, []byte("encoded:hello"), e.Data())
+
+	var got string
+	require.NoError(t, e.DataAs(&got))
+	require.Equal(t, 1, decoded)
+	require.Equal(t, "hello", got)
+}
@@ -0,0 +1,102 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package event
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// MarshalFlat returns a flat representation of e, e.g. for inserting it in
+// a columnar store: the attributes and the extensions are top-level scalar
+// values, named after the members of the JSON format, while the data is a string.
+// When the data is JSON, "data" holds its JSON text, otherwise it holds the
+// same string as in the JSON format, i.e. either the text or, under
+// "data_base64" for 1.0 events, the base64 encoding of the binary data.
+// Extensions are strings, booleans or int32.
+func MarshalFlat(e Event) (map[string]interface{}, error) {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(b, &members); err != nil {
+		return nil, err
+	}
+
+	flat := make(map[string]interface{}, len(members))
+	for name, raw := range members {
+		if name == "data" && hasJSONData(e.DataContentType(), e.DeprecatedDataContentEncoding()) {
+			flat[name] = string(raw)
+			continue
+		}
+		var v interface{}
+		if err := decodeJSONWithNumbers(raw, &v); err != nil {
+			return nil, err
+		}
+		if n, ok := v.(json.Number); ok {
+			i, err := n.Int64()
+			if err != nil {
+				return nil, fmt.Errorf("invalid number for %q: %w", name, err)
+			}
+			v = int32(i)
+		}
+		flat[name] = v
+	}
+	return flat, nil
+}
+
+// UnmarshalFlat returns the event represented by flat, as returned by MarshalFlat.
+// Besides strings, the data can be a []byte, as returned by some database drivers.
+func UnmarshalFlat(flat map[string]interface{}) (Event, error) {
+	members := make(map[string]interface{}, len(flat))
+	for name, v := range flat {
+		members[name] = v
+	}
+	if data, ok := members["data"]; ok && data != nil {
+		contentType, _ := flat["datacontenttype"].(string)
+		encoding, _ := flat["datacontentencoding"].(string)
+		var s string
+		switch d := data.(type) {
+		case string:
+			s = d
+		case []byte:
+			s = string(d)
+		default:
+			return Event{}, fmt.Errorf("invalid data %T, expected a string", data)
+		}
+		if hasJSONData(contentType, encoding) {
+			members["data"] = json.RawMessage(s)
+		} else {
+			members["data"] = s
+		}
+	}
+
+	b, err := json.Marshal(members)
+	if err != nil {
+		return Event{}, err
+	}
+	e := New()
+	if err := e.UnmarshalJSON(b); err != nil {
+		return Event{}, err
+	}
+	return e, nil
+}
+
+// hasJSONData reports whether the data member of the JSON format is the data
+// itself rather than a string, the same way WriteJson does.
+func hasJSONData(contentType, encoding string) bool {
+	if encoding != "" {
+		return false
+	}
+	mediaType := contentType
+	if i := strings.IndexRune(mediaType, ';'); i != -1 {
+		mediaType = mediaType[:i]
+	}
+	mediaType = strings.TrimSpace(strings.ToLower(mediaType))
+	return mediaType == "" || mediaType == ApplicationJSON || mediaType == TextJSON
+}
@@ -0,0 +1,171 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package event_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+)
+
+func TestMarshalFlat(t *testing.T) {
+	newEvent := func(version string) event.Event {
+		e := event.New(version)
+		e.SetID("abc")
+		e.SetType("com.example.test")
+		e.SetSource("/example")
+		e.SetSubject("sub")
+		e.SetTime(time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC))
+		e.SetExtension("str", "x")
+		e.SetExtension("num", 10)
+		e.SetExtension("flag", true)
+		return e
+	}
+
+	testCases := map[string]struct {
+		event func() event.Event
+		want  map[string]interface{}
+	}{
+		"json data": {
+			event: func() event.Event {
+				e := newEvent(event.CloudEventsVersionV1)
+				require.NoError(t, e.SetData(event.ApplicationJSON, map[string]interface{}{"a": map[string]interface{}{"b": 9007199254740993}}))
+				return e
+			},
+			want: map[string]interface{}{
+				"specversion":     "1.0",
+				"id":              "abc",
+				"type":            "com.example.test",
+				"source":          "/example",
+				"subject":         "sub",
+				"time":            "2021-01-02T03:04:05Z",
+				"datacontenttype": "application/json",
+				"str":             "x",
+				"num":             int32(10),
+				"flag":            true,
+				"data":            `{"a":{"b":9007199254740993}}`,
+			},
+		},
+		"text data": {
+			event: func() event.Event {
+				e := newEvent(event.CloudEventsVersionV1)
+				require.NoError(t, e.SetData(event.TextPlain, "hello"))
+				return e
+			},
+			want: map[string]interface{}{
+				"specversion":     "1.0",
+				"id":              "abc",
+				"type":            "com.example.test",
+				"source":          "/example",
+				"subject":         "sub",
+				"time":            "2021-01-02T03:04:05Z",
+				"datacontenttype": "text/plain",
+				"str":             "x",
+				"num":             int32(10),
+				"flag":            true,
+				"data":            "hello",
+			},
+		},
+		"binary data": {
+			event: func() event.Event {
+				e := newEvent(event.CloudEventsVersionV1)
+				require.NoError(t, e.SetData("application/octet-stream", []byte{1, 2, 3}))
+				return e
+			},
+			want: map[string]interface{}{
+				"specversion":     "1.0",
+				"id":              "abc",
+				"type":            "com.example.test",
+				"source":          "/example",
+				"subject":         "sub",
+				"time":            "2021-01-02T03:04:05Z",
+				"datacontenttype": "application/octet-stream",
+				"str":             "x",
+				"num":             int32(10),
+				"flag":            true,
+				"data_base64":     "AQID",
+			},
+		},
+		"v0.3 json data": {
+			event: func() event.Event {
+				e := newEvent(event.CloudEventsVersionV03)
+				require.NoError(t, e.SetData(event.ApplicationJSON, []int{1, 2, 3}))
+				return e
+			},
+			want: map[string]interface{}{
+				"specversion":     "0.3",
+				"id":              "abc",
+				"type":            "com.example.test",
+				"source":          "/example",
+				"subject":         "sub",
+				"time":            "2021-01-02T03:04:05Z",
+				"datacontenttype": "application/json",
+				"str":             "x",
+				"num":             int32(10),
+				"flag":            true,
+				"data":            "[1,2,3]",
+			},
+		},
+		"no data": {
+			event: func() event.Event {
+				return newEvent(event.CloudEventsVersionV1)
+			},
+			want: map[string]interface{}{
+				"specversion": "1.0",
+				"id":          "abc",
+				"type":        "com.example.test",
+				"source":      "/example",
+				"subject":     "sub",
+				"time":        "2021-01-02T03:04:05Z",
+				"str":         "x",
+				"num":         int32(10),
+				"flag":        true,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			e := tc.event()
+			flat, err := event.MarshalFlat(e)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, flat)
+
+			got, err := event.UnmarshalFlat(flat)
+			require.NoError(t, err)
+			require.Equal(t, e.String(), got.String())
+			require.Equal(t, e.Data(), got.Data())
+		})
+	}
+}
+
+func TestUnmarshalFlat(t *testing.T) {
+	t.Run("data as bytes", func(t *testing.T) {
+		e, err := event.UnmarshalFlat(map[string]interface{}{
+			"specversion":     "1.0",
+			"id":              "abc",
+			"type":            "com.example.test",
+			"source":          "/example",
+			"datacontenttype": "application/json",
+			"data":            []byte(`{"a":1}`),
+		})
+		require.NoError(t, err)
+		require.Equal(t, []byte(`{"a":1}`), e.Data())
+	})
+
+	t.Run("invalid data", func(t *testing.T) {
+		_, err := event.UnmarshalFlat(map[string]interface{}{
+			"specversion": "1.0",
+			"id":          "abc",
+			"type":        "com.example.test",
+			"source":      "/example",
+			"data":        42,
+		})
+		require.Error(t, err)
+	})
+}
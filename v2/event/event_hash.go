@@ -0,0 +1,128 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package event
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cloudevents/sdk-go/v2/types"
+)
+
+// ContentHashOptions configures the attributes included by ContentHash.
+type ContentHashOptions struct {
+	// ExcludeID excludes the id attribute from the hash.
+	ExcludeID bool
+	// ExcludeTime excludes the time attribute from the hash.
+	ExcludeTime bool
+}
+
+// ContentHash computes a stable hash of the event attributes, extensions and data.
+// Semantically equal events produce the same hash, regardless of the extensions ordering,
+// of the extension value types (e.g. int32 vs its string representation)
+// and of the JSON data formatting.
+//
+// The hash is computed as the hex encoded SHA-256 digest of a canonical serialization of the event:
+//
+//   - every set attribute (except the ones excluded by opts) and every extension is converted
+//     to its canonical string representation, as defined by types.Format
+//   - if the data media type is JSON, the data is re-encoded with sorted object keys and without
+//     insignificant whitespaces, otherwise the data bytes are used as is
+//   - attributes, extensions and data are serialized as a JSON object with sorted keys,
+//     where data is written in the "data" key if it's JSON, otherwise in the "data_base64" key
+func ContentHash(e Event, opts ContentHashOptions) (string, error) {
+	if e.Context == nil {
+		return "", fmt.Errorf("missing Event.Context")
+	}
+
+	attrs := map[string]interface{}{
+		"specversion": e.SpecVersion(),
+		"type":        e.Type(),
+		"source":      e.Source(),
+	}
+	if !opts.ExcludeID {
+		attrs["id"] = e.ID()
+	}
+	if !opts.ExcludeTime && !e.Time().IsZero() {
+		attrs["time"] = types.FormatTime(e.Time())
+	}
+	if s := e.Subject(); s != "" {
+		attrs["subject"] = s
+	}
+	if s := e.DataSchema(); s != "" {
+		attrs["dataschema"] = s
+	}
+	if s := e.DataContentType(); s != "" {
+		attrs["datacontenttype"] = s
+	}
+	for k, v := range e.Extensions() {
+		s, err := types.Format(v)
+		if err != nil {
+			return "", fmt.Errorf("cannot format extension %q: %w", k, err)
+		}
+		attrs[strings.ToLower(k)] = s
+	}
+
+	data := e.Data()
+	if len(data) != 0 && e.SpecVersion() != CloudEventsVersionV1 {
+		var err error
+		if data, err = e.legacyConvertData(data); err != nil {
+			return "", err
+		}
+	}
+	if len(data) != 0 {
+		if isJSONMediaType(e.DataMediaType()) {
+			canonical, err := canonicalJSON(data)
+			if err != nil {
+				return "", fmt.Errorf("cannot canonicalize JSON data: %w", err)
+			}
+			attrs["data"] = canonical
+		} else {
+			attrs["data_base64"] = data
+		}
+	}
+
+	// encoding/json sorts the map keys, so the serialization is stable
+	b, err := json.Marshal(attrs)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func isJSONMediaType(mediaType string) bool {
+	return mediaType == "" || mediaType == ApplicationJSON || mediaType == TextJSON || strings.HasSuffix(mediaType, "+json")
+}
+
+// canonicalJSON decodes and re-encodes b, preserving the numbers precision.
+func canonicalJSON(b []byte) (json.RawMessage, error) {
+	var v interface{}
+	if err := decodeJSONWithNumbers(b, &v); err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+// decodeJSONWithNumbers decodes the single JSON value b into out, keeping the
+// numbers as json.Number. Unlike json.Decoder.Decode, it rejects any data
+// following the value.
+func decodeJSONWithNumbers(b []byte, out interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(out); err != nil {
+		return err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return fmt.Errorf("unexpected data after the JSON value")
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package event_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+)
+
+func hashTestEvent() event.Event {
+	e := event.New()
+	e.SetID("abc")
+	e.SetType("com.example.test")
+	e.SetSource("/example")
+	e.SetTime(time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC))
+	e.SetExtension("aaa", "x")
+	e.SetExtension("bbb", 10)
+	_ = e.SetData(event.ApplicationJSON, map[string]interface{}{"a": 1, "b": "two"})
+	return e
+}
+
+func TestContentHash(t *testing.T) {
+	base := hashTestEvent()
+	baseHash, err := event.ContentHash(base, event.ContentHashOptions{})
+	require.NoError(t, err)
+	require.Len(t, baseHash, 64)
+
+	testCases := map[string]struct {
+		event func() event.Event
+		opts  event.ContentHashOptions
+		equal bool
+	}{
+		"same event": {
+			event: hashTestEvent,
+			equal: true,
+		},
+		"different json formatting": {
+			event: func() event.Event {
+				e := hashTestEvent()
+				e.DataEncoded = []byte("{ \"b\": \"two\",\n  \"a\": 1 }")
+				return e
+			},
+			equal: true,
+		},
+		"extension as string": {
+			event: func() event.Event {
+				e := hashTestEvent()
+				e.SetExtension("bbb", "10")
+				return e
+			},
+			equal: true,
+		},
+		"different data": {
+			event: func() event.Event {
+				e := hashTestEvent()
+				e.DataEncoded = []byte(`{"a":2,"b":"two"}`)
+				return e
+			},
+		},
+		"different extension": {
+			event: func() event.Event {
+				e := hashTestEvent()
+				e.SetExtension("ccc", "y")
+				return e
+			},
+		},
+		"different id": {
+			event: func() event.Event {
+				e := hashTestEvent()
+				e.SetID("def")
+				return e
+			},
+		},
+		"different time": {
+			event: func() event.Event {
+				e := hashTestEvent()
+				e.SetTime(time.Now())
+				return e
+			},
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			got, err := event.ContentHash(tc.event(), tc.opts)
+			require.NoError(t, err)
+			if tc.equal {
+				require.Equal(t, baseHash, got)
+			} else {
+				require.NotEqual(t, baseHash, got)
+			}
+		})
+	}
+}
+
+func TestContentHash_Exclude(t *testing.T) {
+	a := hashTestEvent()
+	b := hashTestEvent()
+	b.SetID("def")
+	b.SetTime(time.Now())
+
+	opts := event.ContentHashOptions{ExcludeID: true, ExcludeTime: true}
+	hashA, err := event.ContentHash(a, opts)
+	require.NoError(t, err)
+	hashB, err := event.ContentHash(b, opts)
+	require.NoError(t, err)
+	require.Equal(t, hashA, hashB)
+
+	opts = event.ContentHashOptions{ExcludeID: true}
+	hashA, err = event.ContentHash(a, opts)
+	require.NoError(t, err)
+	hashB, err = event.ContentHash(b, opts)
+	require.NoError(t, err)
+	require.NotEqual(t, hashA, hashB)
+}
+
+func TestContentHash_BinaryData(t *testing.T) {
+	a := hashTestEvent()
+	require.NoError(t, a.SetData("application/octet-stream", []byte{0, 1, 2}))
+	b := hashTestEvent()
+	require.NoError(t, b.SetData("application/octet-stream", []byte{0, 1, 3}))
+
+	hashA, err := event.ContentHash(a, event.ContentHashOptions{})
+	require.NoError(t, err)
+	hashB, err := event.ContentHash(b, event.ContentHashOptions{})
+	require.NoError(t, err)
+	require.NotEqual(t, hashA, hashB)
+}
+
+func TestContentHash_InvalidJSONData(t *testing.T) {
+	e := hashTestEvent()
+	e.DataEncoded = []byte(`{"a":`)
+	_, err := event.ContentHash(e, event.ContentHashOptions{})
+	require.Error(t, err)
+}
+
+func TestContentHash_TrailingJSONData(t *testing.T) {
+	e := hashTestEvent()
+	e.DataEncoded = []byte(`{"a":1,"b":"two"} garbage`)
+	_, err := event.ContentHash(e, event.ContentHashOptions{})
+	require.Error(t, err)
+
+	e.DataEncoded = []byte(`{"a":1,"b":"two"}{}`)
+	_, err = event.ContentHash(e, event.ContentHashOptions{})
+	require.Error(t, err)
+}
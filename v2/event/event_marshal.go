@@ -59,6 +59,12 @@ func WriteJson(in *Event, writer io.Writer) error {
 			stream.WriteMore()
 			stream.WriteObjectField("datacontentencoding")
 			stream.WriteString(*eventContext.DataContentEncoding)
+		} else if in.DataBase64 && in.DataEncoded != nil {
+			// Raw data, e.g. read from a binary message, is base64 encoded in the JSON format
+			isBase64 = true
+			stream.WriteMore()
+			stream.WriteObjectField("datacontentencoding")
+			stream.WriteString(Base64)
 		}
 
 		if eventContext.DataContentType != nil {
@@ -402,6 +402,34 @@ func TestMarshal(t *testing.T) {
 	}
 }
 
+func TestMarshal_OmitsUnsetAttributes(t *testing.T) {
+	testCases := map[string]struct {
+		version string
+		want    string
+	}{
+		"v0.3": {
+			version: event.CloudEventsVersionV03,
+			want:    `{"specversion":"0.3","id":"ABC-123","source":"/source","type":"com.example.test"}`,
+		},
+		"v1.0": {
+			version: event.CloudEventsVersionV1,
+			want:    `{"specversion":"1.0","id":"ABC-123","source":"/source","type":"com.example.test"}`,
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			e := event.New(tc.version)
+			e.SetID("ABC-123")
+			e.SetSource("/source")
+			e.SetType("com.example.test")
+
+			got, err := json.Marshal(e)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
 func mustJsonMarshal(tb testing.TB, body interface{}) []byte {
 	b, err := json.Marshal(body)
 	require.NoError(tb, err)
@@ -420,3 +448,42 @@ func assertJsonEquals(t *testing.T, want map[string]interface{}, got []byte) {
 
 	require.Equal(t, wantToCompare, gotToCompare)
 }
+
+func TestMarshal_Golden(t *testing.T) {
+	testCases := map[string]struct {
+		version string
+		want    string
+	}{
+		"v0.3": {
+			version: event.CloudEventsVersionV03,
+			want: `{"specversion":"0.3","id":"ABC-123","source":"/source","type":"com.example.test","subject":"sub",` +
+				`"time":"2026-01-02T03:04:05Z","datacontenttype":"application/json","exint":42,"exstring":"hello","data":{"a":"b"}}`,
+		},
+		"v1.0": {
+			version: event.CloudEventsVersionV1,
+			want: `{"specversion":"1.0","id":"ABC-123","source":"/source","type":"com.example.test","subject":"sub",` +
+				`"time":"2026-01-02T03:04:05Z","datacontenttype":"application/json","exint":42,"exstring":"hello","data":{"a":"b"}}`,
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			e := event.New(tc.version)
+			e.SetID("ABC-123")
+			e.SetSource("/source")
+			e.SetType("com.example.test")
+			e.SetSubject("sub")
+			e.SetTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+			e.SetExtension("exstring", "hello")
+			e.SetExtension("exint", 42)
+			require.NoError(t, e.SetData(event.ApplicationJSON, map[string]string{"a": "b"}))
+
+			got, err := json.Marshal(e)
+			require.NoError(t, err)
+			require.JSONEq(t, tc.want, string(got))
+
+			var roundTrip event.Event
+			require.NoError(t, json.Unmarshal(got, &roundTrip))
+			require.Equal(t, e.String(), roundTrip.String())
+		})
+	}
+}
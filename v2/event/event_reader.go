@@ -6,7 +6,11 @@
 package event
 
 import (
+	"fmt"
+	"sort"
 	"time"
+
+	"github.com/cloudevents/sdk-go/v2/types"
 )
 
 var _ EventReader = (*Event)(nil)
@@ -101,3 +105,72 @@ func (e Event) Extensions() map[string]interface{} {
 	}
 	return map[string]interface{}(nil)
 }
+
+// ForEachAttribute calls fn with the name and the value of each set attribute
+// of e, then of each extension, whatever the spec version of e. The attributes
+// are named as in the spec version of e, e.g. schemaurl for the 0.3 dataschema,
+// and visited in the order of the spec. Their values are strings, except time,
+// a time.Time. The extensions are visited in the order of their names.
+func (e Event) ForEachAttribute(fn func(name string, value interface{})) {
+	if e.Context == nil {
+		return
+	}
+	visit := func(name, value string) {
+		if value != "" {
+			fn(name, value)
+		}
+	}
+	visit("specversion", e.SpecVersion())
+	visit("id", e.ID())
+	visit("source", e.Source())
+	visit("type", e.Type())
+	visit("datacontenttype", e.DataContentType())
+	if e.SpecVersion() == CloudEventsVersionV03 {
+		visit(DataContentEncodingKey, e.DeprecatedDataContentEncoding())
+		visit("schemaurl", e.DataSchema())
+	} else {
+		visit("dataschema", e.DataSchema())
+	}
+	visit("subject", e.Subject())
+	if t := e.Time(); !t.IsZero() {
+		fn("time", t)
+	}
+
+	exts := e.Extensions()
+	names := make([]string, 0, len(exts))
+	for name := range exts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fn(name, exts[name])
+	}
+}
+
+// ExtensionString returns the extension name, case insensitively, as a string,
+// formatted with types.Format if it's not one. It fails if the extension is not set.
+func (e Event) ExtensionString(name string) (string, error) {
+	v, err := e.extension(name)
+	if err != nil {
+		return "", err
+	}
+	return types.Format(v)
+}
+
+// ExtensionInteger returns the extension name, case insensitively, converted to
+// an integer with types.ToInteger, e.g. from the string "42" of a binary
+// message. It fails if the extension is not set.
+func (e Event) ExtensionInteger(name string) (int32, error) {
+	v, err := e.extension(name)
+	if err != nil {
+		return 0, err
+	}
+	return types.ToInteger(v)
+}
+
+func (e Event) extension(name string) (interface{}, error) {
+	if e.Context == nil {
+		return nil, fmt.Errorf("%q not found", name)
+	}
+	return e.Context.GetExtension(name)
+}
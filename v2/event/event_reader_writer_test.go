@@ -498,3 +498,94 @@ func validateReaderWriterCorrected(t *testing.T, tc ReadWriteTest, got interface
 		t.Errorf("unexpected (-want, +got) = %v", diff)
 	}
 }
+
+func TestEvent_ForEachAttribute(t *testing.T) {
+	now := time.Date(2020, 3, 21, 12, 34, 56, 0, time.UTC)
+	type attribute struct {
+		Name  string
+		Value interface{}
+	}
+	newEvent := func(version string) event.Event {
+		e := event.New(version)
+		e.SetID("ABC-123")
+		e.SetSource("http://example.com/source")
+		e.SetType("com.example.test")
+		e.SetSubject("topic")
+		e.SetTime(now)
+		e.SetDataSchema("http://example.com/schema")
+		e.SetExtension("zeta", "z")
+		e.SetExtension("alpha", int32(1))
+		_ = e.SetData(event.TextPlain, "hello")
+		return e
+	}
+
+	testCases := map[string]struct {
+		event event.Event
+		want  []attribute
+	}{
+		"v1.0": {
+			event: newEvent(event.CloudEventsVersionV1),
+			want: []attribute{
+				{"specversion", "1.0"},
+				{"id", "ABC-123"},
+				{"source", "http://example.com/source"},
+				{"type", "com.example.test"},
+				{"datacontenttype", "text/plain"},
+				{"dataschema", "http://example.com/schema"},
+				{"subject", "topic"},
+				{"time", now},
+				{"alpha", int32(1)},
+				{"zeta", "z"},
+			},
+		},
+		"v0.3": {
+			event: func() event.Event {
+				e := newEvent(event.CloudEventsVersionV03)
+				_ = e.Context.DeprecatedSetDataContentEncoding(event.Base64)
+				return e
+			}(),
+			want: []attribute{
+				{"specversion", "0.3"},
+				{"id", "ABC-123"},
+				{"source", "http://example.com/source"},
+				{"type", "com.example.test"},
+				{"datacontenttype", "text/plain"},
+				{"datacontentencoding", "base64"},
+				{"schemaurl", "http://example.com/schema"},
+				{"subject", "topic"},
+				{"time", now},
+				{"alpha", int32(1)},
+				{"zeta", "z"},
+			},
+		},
+		"unset attributes": {
+			event: func() event.Event {
+				e := event.New()
+				e.SetID("ABC-123")
+				e.SetSource("http://example.com/source")
+				e.SetType("com.example.test")
+				return e
+			}(),
+			want: []attribute{
+				{"specversion", "1.0"},
+				{"id", "ABC-123"},
+				{"source", "http://example.com/source"},
+				{"type", "com.example.test"},
+			},
+		},
+		"nil context": {
+			event: event.Event{},
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			var got []attribute
+			tc.event.ForEachAttribute(func(name string, value interface{}) {
+				got = append(got, attribute{name, value})
+			})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected attributes (-want, +got) = %v", diff)
+			}
+		})
+	}
+}
@@ -543,3 +543,96 @@ func TestEvent_Clone(t *testing.T) {
 	require.Equal(t, []byte("\"aaa\""), original.Data())
 	require.Equal(t, []byte("\"bbb\""), clone.Data())
 }
+
+func TestEvent_SetExtensions(t *testing.T) {
+	e := event.New()
+	require.NoError(t, e.SetExtensions(map[string]interface{}{
+		"team":     "payments",
+		"priority": 3,
+		"internal": true,
+	}))
+	require.Equal(t, map[string]interface{}{
+		"team":     "payments",
+		"priority": int32(3),
+		"internal": true,
+	}, e.Extensions())
+
+	// invalid names: nothing is set
+	e = event.New()
+	err := e.SetExtensions(map[string]interface{}{
+		"team":     "payments",
+		"bad_name": "a",
+		"":         "b",
+	})
+	var validationErr event.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	require.Len(t, validationErr, 2)
+	require.Contains(t, validationErr, "extension:bad_name")
+	require.Contains(t, validationErr, "extension:")
+	require.Empty(t, e.Extensions())
+
+	// invalid values: the other extensions are set
+	e = event.New()
+	err = e.SetExtensions(map[string]interface{}{
+		"team":  "payments",
+		"value": struct{}{},
+	})
+	require.ErrorAs(t, err, &validationErr)
+	require.Len(t, validationErr, 1)
+	require.Contains(t, validationErr, "extension:value")
+	require.Equal(t, map[string]interface{}{"team": "payments"}, e.Extensions())
+}
+
+func TestEvent_Reset(t *testing.T) {
+	for _, version := range []string{event.CloudEventsVersionV03, event.CloudEventsVersionV1} {
+		t.Run(version, func(t *testing.T) {
+			e := event.New(version)
+			e.SetID("abc")
+			e.SetType("unit.test")
+			e.SetSource("/unit/test")
+			e.SetExtension("exstring", "value")
+			require.NoError(t, e.SetData(event.ApplicationJSON, map[string]string{"hello": "world"}))
+			ec := e.Context
+
+			e.Reset()
+
+			require.Same(t, ec, e.Context)
+			require.Empty(t, e.ID())
+			require.Empty(t, e.Type())
+			require.Empty(t, e.Extensions())
+			require.Nil(t, e.Data())
+			require.False(t, e.DataBase64)
+
+			// The reset event can be decoded into, reusing the context
+			want := event.New(version)
+			want.SetID("def")
+			want.SetType("unit.test.2")
+			want.SetSource("/unit/test/2")
+			want.SetExtension("exint", 42)
+			b, err := want.MarshalJSON()
+			require.NoError(t, err)
+
+			require.NoError(t, e.UnmarshalJSON(b))
+			require.Same(t, ec, e.Context)
+			require.Equal(t, "def", e.ID())
+			require.Equal(t, "unit.test.2", e.Type())
+			require.Equal(t, map[string]interface{}{"exint": int32(42)}, e.Extensions())
+		})
+	}
+}
+
+func TestEvent_ResetNilContext(t *testing.T) {
+	e := event.Event{DataEncoded: []byte("data")}
+	e.Reset()
+	require.Nil(t, e.Context)
+	require.Nil(t, e.DataEncoded)
+}
+
+func TestUnmarshal_DoesNotReuseSharedContext(t *testing.T) {
+	proto := event.New()
+	e := proto
+
+	require.NoError(t, e.UnmarshalJSON([]byte(`{"specversion":"1.0","id":"abc","type":"unit.test","source":"/unit/test"}`)))
+	require.NotSame(t, proto.Context, e.Context)
+	require.Empty(t, proto.ID())
+}
@@ -30,9 +30,18 @@ func appendFlag(state *uint8, flag uint8) {
 	*state = (*state) | flag
 }
 
+// unmarshalConfig is jsoniter.ConfigFastest, but reads numbers as json.Number
+// so that large integer extension values are not rounded through float64.
+var unmarshalConfig = jsoniter.Config{
+	EscapeHTML:                    false,
+	MarshalFloatWith6Digits:       true,
+	ObjectFieldMustBeSimpleString: true,
+	UseNumber:                     true,
+}.Froze()
+
 var iterPool = sync.Pool{
 	New: func() interface{} {
-		return jsoniter.Parse(jsoniter.ConfigFastest, nil, 1024)
+		return jsoniter.Parse(unmarshalConfig, nil, 1024)
 	},
 }
 
@@ -55,6 +64,24 @@ func ReadJson(out *Event, reader io.Reader) error {
 	return readJsonFromIterator(out, iterator)
 }
 
+// reusableContextV1 returns the context of out if it was cleared by Event.Reset,
+// otherwise a new context.
+func reusableContextV1(out *Event) *EventContextV1 {
+	if ec, ok := out.Context.(*EventContextV1); ok && ec.Extensions != nil && len(ec.Extensions) == 0 {
+		return ec
+	}
+	return &EventContextV1{}
+}
+
+// reusableContextV03 returns the context of out if it was cleared by Event.Reset,
+// otherwise a new context.
+func reusableContextV03(out *Event) *EventContextV03 {
+	if ec, ok := out.Context.(*EventContextV03); ok && ec.Extensions != nil && len(ec.Extensions) == 0 {
+		return ec
+	}
+	return &EventContextV03{}
+}
+
 // ReadJson allows you to read the bytes reader as an event
 func readJsonFromIterator(out *Event, iterator *jsoniter.Iterator) error {
 	// Parsing dependency graph:
@@ -108,13 +135,15 @@ func readJsonFromIterator(out *Event, iterator *jsoniter.Iterator) error {
 			// Check proper specversion
 			switch sv {
 			case CloudEventsVersionV1:
-				con := &EventContextV1{
+				con := reusableContextV1(out)
+				*con = EventContextV1{
 					ID:              id,
 					Type:            typ,
 					Source:          source,
 					Subject:         subject,
 					Time:            time,
 					DataContentType: datacontenttype,
+					Extensions:      con.Extensions,
 				}
 
 				// Add the fields relevant for the version ...
@@ -147,13 +176,15 @@ func readJsonFromIterator(out *Event, iterator *jsoniter.Iterator) error {
 				out.Context = con
 				appendFlag(&state, specVersionV1Flag)
 			case CloudEventsVersionV03:
-				con := &EventContextV03{
+				con := reusableContextV03(out)
+				*con = EventContextV03{
 					ID:              id,
 					Type:            typ,
 					Source:          source,
 					Subject:         subject,
 					Time:            time,
 					DataContentType: datacontenttype,
+					Extensions:      con.Extensions,
 				}
 				var err error
 				// Add the fields relevant for the version ...
@@ -474,7 +505,7 @@ func toUriPtr(val jsoniter.Any) (*types.URI, error) {
 // UnmarshalJSON implements the json unmarshal method used when this type is
 // unmarshaled using json.Unmarshal.
 func (e *Event) UnmarshalJSON(b []byte) error {
-	iterator := jsoniter.ConfigFastest.BorrowIterator(b)
-	defer jsoniter.ConfigFastest.ReturnIterator(iterator)
+	iterator := unmarshalConfig.BorrowIterator(b)
+	defer unmarshalConfig.ReturnIterator(iterator)
 	return readJsonFromIterator(e, iterator)
 }
@@ -190,3 +190,39 @@ func BenchmarkUnmarshal(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkUnmarshalReset(b *testing.B) {
+	now := types.Timestamp{Time: time.Now().UTC()}
+	bytes := []byte(new(orderedJsonObjectBuilder).Start().
+		Add("specversion", "1.0").
+		Add("datacontenttype", "application/json").
+		Add("data", map[string]interface{}{
+			"a": 42,
+			"b": "testing",
+		}).
+		Add("id", "ABC-123").
+		Add("time", now.Format(time.RFC3339Nano)).
+		Add("type", "com.example.test").
+		Add("exbool", true).
+		Add("exint", 42).
+		Add("exstring", "exstring").
+		Add("source", "http://example.com/source").
+		End())
+
+	b.Run("new event", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Event = event.Event{}
+			Error = json.Unmarshal(bytes, &Event)
+		}
+	})
+	b.Run("reset event", func(b *testing.B) {
+		b.ReportAllocs()
+		var e event.Event
+		for i := 0; i < b.N; i++ {
+			e.Reset()
+			Error = e.UnmarshalJSON(bytes)
+		}
+		Event = e
+	})
+}
@@ -1064,6 +1064,28 @@ func TestCloudEventUnmarshalling_invalidOrdering(t *testing.T) {
 	}
 }
 
+func TestUnmarshalLargeIntegers(t *testing.T) {
+	body := []byte(`{
+		"specversion": "1.0",
+		"id": "ABC-123",
+		"type": "com.example.test",
+		"source": "http://example.com/source",
+		"exint": 42,
+		"exbig": 9007199254740993,
+		"datacontenttype": "application/json",
+		"data": {"id": 9007199254740993}
+	}`)
+
+	e := event.New()
+	require.NoError(t, json.Unmarshal(body, &e))
+	require.Equal(t, int32(42), e.Extensions()["exint"])
+	require.Equal(t, "9007199254740993", e.Extensions()["exbig"])
+
+	var data map[string]interface{}
+	require.NoError(t, e.DataAs(&data))
+	require.Equal(t, json.Number("9007199254740993"), data["id"])
+}
+
 // This is a little hack we need to create a json ordered.
 // This makes the bench reproducible for unmarshal
 type orderedJsonObjectBuilder strings.Builder
@@ -107,11 +107,42 @@ func (e *Event) SetDataContentEncoding(enc string) {
 	}
 }
 
-// SetExtension implements EventWriter.SetExtension
+// SetExtension implements EventWriter.SetExtension.
+// The name must consist of lower-case letters or digits, as required by the spec.
 func (e *Event) SetExtension(name string, obj interface{}) {
-	if err := e.Context.SetExtension(name, obj); err != nil {
+	if err := validateSetExtensionName(name); err != nil {
+		e.fieldError("extension:"+name, err)
+	} else if err := e.Context.SetExtension(name, obj); err != nil {
 		e.fieldError("extension:"+name, err)
 	} else {
 		e.fieldOK("extension:" + name)
 	}
 }
+
+// SetExtensions sets all the extensions of the map, e.g. to tag the event with
+// a set of labels. The names are validated first: if any of them is invalid,
+// none of the extensions is set and a ValidationError listing the invalid names
+// is returned. Values which cannot be converted to a CloudEvents type are
+// reported the same way, but the other extensions are set.
+func (e *Event) SetExtensions(extensions map[string]interface{}) error {
+	errs := ValidationError{}
+	for name := range extensions {
+		if err := validateSetExtensionName(name); err != nil {
+			errs["extension:"+name] = err
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	for name, obj := range extensions {
+		e.SetExtension(name, obj)
+		if err, ok := e.FieldErrors["extension:"+name]; ok {
+			errs["extension:"+name] = err
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
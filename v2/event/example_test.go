@@ -0,0 +1,40 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package event_test
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+)
+
+func ExampleEvent_Reset() {
+	pool := sync.Pool{
+		New: func() interface{} {
+			return new(event.Event)
+		},
+	}
+
+	for _, b := range [][]byte{
+		[]byte(`{"specversion":"1.0","id":"1","type":"example.created","source":"/example","count":1}`),
+		[]byte(`{"specversion":"1.0","id":"2","type":"example.deleted","source":"/example"}`),
+	} {
+		e := pool.Get().(*event.Event)
+		if err := e.UnmarshalJSON(b); err != nil {
+			panic(err)
+		}
+		fmt.Println(e.ID(), e.Type(), e.Extensions())
+
+		// Clear the event before returning it to the pool, so that the next
+		// decoding reuses its context.
+		e.Reset()
+		pool.Put(e)
+	}
+	// Output:
+	// 1 example.created map[count:1]
+	// 2 example.deleted map[]
+}
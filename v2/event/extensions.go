@@ -55,3 +55,18 @@ func validateExtensionName(key string) error {
 	}
 	return nil
 }
+
+// validateSetExtensionName validates the name of an extension set by the user:
+// unlike the names read from the messages, which are lower cased, upper-case
+// letters are rejected, as the spec only allows lower-case letters and digits.
+func validateSetExtensionName(key string) error {
+	if err := validateExtensionName(key); err != nil {
+		return err
+	}
+	for _, c := range key {
+		if c >= 'A' && c <= 'Z' {
+			return errors.New("bad key, CloudEvents attribute names MUST consist of lower-case letters ('a' to 'z') or digits ('0' to '9') from the ASCII character set")
+		}
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ package event
 
 import (
 	"errors"
+	"strings"
 	"testing"
 )
 
@@ -38,3 +39,82 @@ func TestEvent_validateExtensionName(t *testing.T) {
 		})
 	}
 }
+
+func TestEvent_ExtensionString(t *testing.T) {
+	e := New()
+	e.SetExtension("exstring", "hello")
+	e.SetExtension("exint", 42)
+
+	for name, want := range map[string]string{"exstring": "hello", "EXSTRING": "hello", "exint": "42"} {
+		got, err := e.ExtensionString(name)
+		if err != nil || got != want {
+			t.Errorf("ExtensionString(%q) = %q, %v, want %q", name, got, err, want)
+		}
+	}
+	if _, err := e.ExtensionString("missing"); err == nil {
+		t.Errorf("expected an error for a missing extension")
+	}
+	if _, err := (Event{}).ExtensionString("exstring"); err == nil {
+		t.Errorf("expected an error for an event without context")
+	}
+}
+
+func TestEvent_ExtensionInteger(t *testing.T) {
+	e := New()
+	e.SetExtension("exint", 42)
+	e.SetExtension("exstring", "43")
+	e.SetExtension("exnotint", "hello")
+
+	for name, want := range map[string]int32{"exint": 42, "ExInt": 42, "exstring": 43} {
+		got, err := e.ExtensionInteger(name)
+		if err != nil || got != want {
+			t.Errorf("ExtensionInteger(%q) = %d, %v, want %d", name, got, err, want)
+		}
+	}
+	for _, name := range []string{"exnotint", "missing"} {
+		if _, err := e.ExtensionInteger(name); err == nil {
+			t.Errorf("ExtensionInteger(%q): expected an error", name)
+		}
+	}
+
+	// Setting nil deletes the extension
+	e.SetExtension("exint", nil)
+	if _, err := e.ExtensionInteger("exint"); err == nil {
+		t.Errorf("expected an error for a deleted extension")
+	}
+}
+
+func TestEvent_SetExtensionName(t *testing.T) {
+	for name, wantErr := range map[string]bool{
+		"myext":  false,
+		"myext1": false,
+		"myExt":  true,
+		"my-ext": true,
+		"my_ext": true,
+		"":       true,
+	} {
+		e := New()
+		e.SetExtension(name, "value")
+		_, gotErr := e.FieldErrors["extension:"+name]
+		if gotErr != wantErr {
+			t.Errorf("SetExtension(%q): got error %v, want error %v", name, e.FieldErrors["extension:"+name], wantErr)
+		}
+		if _, set := e.Extensions()[strings.ToLower(name)]; set == wantErr {
+			t.Errorf("SetExtension(%q): extension set %v, want %v", name, set, !wantErr)
+		}
+
+		e = New()
+		if err := e.SetExtensions(map[string]interface{}{name: "value"}); (err != nil) != wantErr {
+			t.Errorf("SetExtensions(%q) = %v, want error %v", name, err, wantErr)
+		}
+	}
+
+	// The names read from the messages are lower cased
+	e := New()
+	if err := e.Context.SetExtension("myExt", "value"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := e.Extensions()["myext"]; !ok {
+		t.Errorf("expected the extension myext, got %v", e.Extensions())
+	}
+}
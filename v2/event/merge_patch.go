@@ -0,0 +1,66 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package event
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// ApplyMergePatch applies the JSON Merge Patch (RFC 7386) carried in the data of e onto base,
+// returning the patched document. The data content type of e must be "application/merge-patch+json".
+// An empty base is treated as an empty document.
+func ApplyMergePatch(base json.RawMessage, e Event) (json.RawMessage, error) {
+	if mt := e.DataMediaType(); mt != ApplicationMergePatchJSON {
+		return nil, fmt.Errorf("unexpected data media type %q, expected %q", mt, ApplicationMergePatchJSON)
+	}
+
+	data := e.Data()
+	if len(data) != 0 && e.SpecVersion() != CloudEventsVersionV1 {
+		var err error
+		if data, err = e.legacyConvertData(data); err != nil {
+			return nil, err
+		}
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("missing merge patch in event data")
+	}
+
+	var patch interface{}
+	if err := decodeJSONWithNumbers(data, &patch); err != nil {
+		return nil, fmt.Errorf("failed to decode the merge patch: %w", err)
+	}
+
+	var target interface{}
+	if len(bytes.TrimSpace(base)) != 0 {
+		if err := decodeJSONWithNumbers(base, &target); err != nil {
+			return nil, fmt.Errorf("failed to decode the base document: %w", err)
+		}
+	}
+
+	return json.Marshal(mergePatch(target, patch))
+}
+
+// mergePatch implements the MergePatch function defined in RFC 7386, section 2.
+func mergePatch(target, patch interface{}) interface{} {
+	patchObj, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	targetObj, ok := target.(map[string]interface{})
+	if !ok {
+		targetObj = make(map[string]interface{}, len(patchObj))
+	}
+	for k, v := range patchObj {
+		if v == nil {
+			delete(targetObj, k)
+		} else {
+			targetObj[k] = mergePatch(targetObj[k], v)
+		}
+	}
+	return targetObj
+}
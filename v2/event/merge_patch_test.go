@@ -0,0 +1,101 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package event_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+)
+
+func TestApplyMergePatch(t *testing.T) {
+	// Test cases from RFC 7386, Appendix A
+	testCases := []struct {
+		base  string
+		patch string
+		want  string
+	}{
+		{base: `{"a":"b"}`, patch: `{"a":"c"}`, want: `{"a":"c"}`},
+		{base: `{"a":"b"}`, patch: `{"b":"c"}`, want: `{"a":"b","b":"c"}`},
+		{base: `{"a":"b"}`, patch: `{"a":null}`, want: `{}`},
+		{base: `{"a":"b","b":"c"}`, patch: `{"a":null}`, want: `{"b":"c"}`},
+		{base: `{"a":["b"]}`, patch: `{"a":"c"}`, want: `{"a":"c"}`},
+		{base: `{"a":"c"}`, patch: `{"a":["b"]}`, want: `{"a":["b"]}`},
+		{base: `{"a":{"b":"c"}}`, patch: `{"a":{"b":"d","c":null}}`, want: `{"a":{"b":"d"}}`},
+		{base: `{"a":[{"b":"c"}]}`, patch: `{"a":[1]}`, want: `{"a":[1]}`},
+		{base: `["a","b"]`, patch: `["c","d"]`, want: `["c","d"]`},
+		{base: `{"a":"b"}`, patch: `["c"]`, want: `["c"]`},
+		{base: `{"a":"foo"}`, patch: `null`, want: `null`},
+		{base: `{"a":"foo"}`, patch: `"bar"`, want: `"bar"`},
+		{base: `{"e":null}`, patch: `{"a":1}`, want: `{"a":1,"e":null}`},
+		{base: `[1,2]`, patch: `{"a":"b","c":null}`, want: `{"a":"b"}`},
+		{base: `{}`, patch: `{"a":{"bb":{"ccc":null}}}`, want: `{"a":{"bb":{}}}`},
+		// Empty base and number precision
+		{base: ``, patch: `{"a":9007199254740993}`, want: `{"a":9007199254740993}`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.base+" + "+tc.patch, func(t *testing.T) {
+			e := event.New()
+			e.SetDataContentType(event.ApplicationMergePatchJSON)
+			e.DataEncoded = []byte(tc.patch)
+
+			got, err := event.ApplyMergePatch(json.RawMessage(tc.base), e)
+			require.NoError(t, err)
+			require.JSONEq(t, tc.want, string(got))
+			if tc.base == "" {
+				require.Equal(t, tc.want, string(got))
+			}
+		})
+	}
+}
+
+func TestApplyMergePatch_Errors(t *testing.T) {
+	t.Run("wrong content type", func(t *testing.T) {
+		e := event.New()
+		require.NoError(t, e.SetData(event.ApplicationJSON, map[string]string{"a": "b"}))
+		_, err := event.ApplyMergePatch(json.RawMessage(`{}`), e)
+		require.EqualError(t, err, `unexpected data media type "application/json", expected "application/merge-patch+json"`)
+	})
+
+	t.Run("missing patch", func(t *testing.T) {
+		e := event.New()
+		e.SetDataContentType(event.ApplicationMergePatchJSON)
+		_, err := event.ApplyMergePatch(json.RawMessage(`{}`), e)
+		require.EqualError(t, err, "missing merge patch in event data")
+	})
+
+	t.Run("invalid base", func(t *testing.T) {
+		e := event.New()
+		e.SetDataContentType(event.ApplicationMergePatchJSON)
+		e.DataEncoded = []byte(`{"a":"b"}`)
+		_, err := event.ApplyMergePatch(json.RawMessage(`{`), e)
+		require.Error(t, err)
+	})
+
+	for _, tc := range []struct {
+		name    string
+		base    string
+		patch   string
+		wantErr string
+	}{
+		{name: "malformed patch", base: `{}`, patch: `{"a":`, wantErr: "failed to decode the merge patch: unexpected EOF"},
+		{name: "patch with trailing data", base: `{}`, patch: `{"a":1} garbage`, wantErr: "failed to decode the merge patch: unexpected data after the JSON value"},
+		{name: "multiple patches", base: `{}`, patch: `{}{}`, wantErr: "failed to decode the merge patch: unexpected data after the JSON value"},
+		{name: "base with trailing data", base: `{"a":1} garbage`, patch: `{}`, wantErr: "failed to decode the base document: unexpected data after the JSON value"},
+		{name: "multiple bases", base: `{}{}`, patch: `{}`, wantErr: "failed to decode the base document: unexpected data after the JSON value"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			e := event.New()
+			e.SetDataContentType(event.ApplicationMergePatchJSON)
+			e.DataEncoded = []byte(tc.patch)
+			_, err := event.ApplyMergePatch(json.RawMessage(tc.base), e)
+			require.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
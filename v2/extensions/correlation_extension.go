@@ -0,0 +1,48 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package extensions
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/types"
+)
+
+const (
+	// CorrelationIDExtension is the extension holding the id shared by all the
+	// events of a chain of events, from the first event to the last derived one.
+	// Unlike an id of the immediate parent event, it is carried unchanged along the chain.
+	CorrelationIDExtension = "correlationid"
+)
+
+// SetCorrelationID sets the correlationid extension of the event.
+func SetCorrelationID(e event.EventWriter, correlationID string) {
+	e.SetExtension(CorrelationIDExtension, correlationID)
+}
+
+// GetCorrelationID returns the correlationid extension of the event, if set.
+func GetCorrelationID(e event.Event) (string, bool) {
+	if v, ok := e.Extensions()[CorrelationIDExtension]; ok {
+		if s, err := types.ToString(v); err == nil && s != "" {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// DeriveFrom carries the correlation id of parent forward to child, an event
+// created in response to parent. If parent has no correlation id, a new one is
+// generated, so child starts a new chain.
+// It returns the correlation id set on child.
+func DeriveFrom(child event.EventWriter, parent event.Event) string {
+	correlationID, ok := GetCorrelationID(parent)
+	if !ok {
+		correlationID = uuid.New().String()
+	}
+	SetCorrelationID(child, correlationID)
+	return correlationID
+}
@@ -0,0 +1,48 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package extensions_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/extensions"
+)
+
+func TestCorrelationID(t *testing.T) {
+	e := event.New()
+	_, ok := extensions.GetCorrelationID(e)
+	require.False(t, ok)
+
+	extensions.SetCorrelationID(&e, "abc")
+	got, ok := extensions.GetCorrelationID(e)
+	require.True(t, ok)
+	require.Equal(t, "abc", got)
+}
+
+func TestDeriveFrom(t *testing.T) {
+	root := event.New()
+
+	// The root has no correlation id, a new one is generated
+	child := event.New()
+	correlationID := extensions.DeriveFrom(&child, root)
+	require.NotEmpty(t, correlationID)
+	got, ok := extensions.GetCorrelationID(child)
+	require.True(t, ok)
+	require.Equal(t, correlationID, got)
+
+	// The correlation id is carried along the chain
+	grandChild := event.New()
+	require.Equal(t, correlationID, extensions.DeriveFrom(&grandChild, child))
+	got, _ = extensions.GetCorrelationID(grandChild)
+	require.Equal(t, correlationID, got)
+
+	// Unrelated chains get different correlation ids
+	other := event.New()
+	require.NotEqual(t, correlationID, extensions.DeriveFrom(&other, root))
+}
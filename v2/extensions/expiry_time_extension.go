@@ -0,0 +1,41 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package extensions
+
+import (
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/types"
+)
+
+const (
+	// ExpiryTimeExtension is the extension holding the time after which an
+	// event is no longer relevant and should be discarded.
+	ExpiryTimeExtension = "expirytime"
+)
+
+// SetExpiryTime sets the expirytime extension of the event to t.
+func SetExpiryTime(e event.EventWriter, t time.Time) {
+	e.SetExtension(ExpiryTimeExtension, t)
+}
+
+// GetExpiryTime returns the expirytime extension of the event, if set and valid.
+func GetExpiryTime(e event.Event) (time.Time, bool) {
+	if v, ok := e.Extensions()[ExpiryTimeExtension]; ok {
+		if t, err := types.ToTime(v); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// IsExpired reports whether the expirytime of the event is before now.
+// Events without an expirytime never expire.
+func IsExpired(e event.Event, now time.Time) bool {
+	t, ok := GetExpiryTime(e)
+	return ok && t.Before(now)
+}
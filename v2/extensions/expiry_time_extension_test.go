@@ -0,0 +1,38 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package extensions_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/extensions"
+)
+
+func TestExpiryTime(t *testing.T) {
+	now := time.Date(2020, 3, 21, 12, 0, 0, 0, time.UTC)
+
+	e := event.New()
+	_, ok := extensions.GetExpiryTime(e)
+	require.False(t, ok)
+	require.False(t, extensions.IsExpired(e, now))
+
+	extensions.SetExpiryTime(&e, now)
+	got, ok := extensions.GetExpiryTime(e)
+	require.True(t, ok)
+	require.True(t, now.Equal(got))
+	require.False(t, extensions.IsExpired(e, now))
+	require.True(t, extensions.IsExpired(e, now.Add(time.Second)))
+
+	// the string form, e.g. from a binary message
+	e.SetExtension(extensions.ExpiryTimeExtension, "2020-03-21T12:00:00Z")
+	got, ok = extensions.GetExpiryTime(e)
+	require.True(t, ok)
+	require.True(t, now.Equal(got))
+}
@@ -0,0 +1,54 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package extensions
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/types"
+)
+
+const (
+	// InReplyToExtension is the extension holding the id of the request event
+	// a reply event responds to, in request/reply flows.
+	InReplyToExtension = "inreplyto"
+)
+
+// SetInReplyTo sets the inreplyto extension of the event.
+func SetInReplyTo(e event.EventWriter, requestID string) {
+	e.SetExtension(InReplyToExtension, requestID)
+}
+
+// GetInReplyTo returns the inreplyto extension of the event, if set.
+func GetInReplyTo(e event.Event) (string, bool) {
+	if v, ok := e.Extensions()[InReplyToExtension]; ok {
+		if s, err := types.ToString(v); err == nil && s != "" {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// NewReply creates the reply to request sent by the responder identified by
+// source, of type responseType, with the same spec version as request, a new
+// id and the current time.
+// The inreplyto extension is set to the id of request and the correlationid
+// extension, if any, is copied from request.
+func NewReply(request event.Event, source, responseType string) event.Event {
+	reply := event.New(request.SpecVersion())
+	reply.SetID(uuid.New().String())
+	reply.SetSource(source)
+	reply.SetType(responseType)
+	reply.SetTime(time.Now())
+	SetInReplyTo(&reply, request.ID())
+	if correlationID, ok := GetCorrelationID(request); ok {
+		SetCorrelationID(&reply, correlationID)
+	}
+	return reply
+}
@@ -0,0 +1,59 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package extensions_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/extensions"
+)
+
+func TestInReplyTo(t *testing.T) {
+	e := event.New()
+	_, ok := extensions.GetInReplyTo(e)
+	require.False(t, ok)
+
+	extensions.SetInReplyTo(&e, "request-id")
+	got, ok := extensions.GetInReplyTo(e)
+	require.True(t, ok)
+	require.Equal(t, "request-id", got)
+}
+
+func TestNewReply(t *testing.T) {
+	request := event.New(event.CloudEventsVersionV03)
+	request.SetID("request-id")
+	request.SetSource("/requester")
+	request.SetType("com.example.request")
+	extensions.SetCorrelationID(&request, "correlation-id")
+
+	reply := extensions.NewReply(request, "/responder", "com.example.response")
+	require.NoError(t, reply.Validate())
+	require.Equal(t, event.CloudEventsVersionV03, reply.SpecVersion())
+	require.NotEmpty(t, reply.ID())
+	require.NotEqual(t, request.ID(), reply.ID())
+	require.Equal(t, "/responder", reply.Source())
+	require.Equal(t, "com.example.response", reply.Type())
+	require.False(t, reply.Time().IsZero())
+
+	inReplyTo, ok := extensions.GetInReplyTo(reply)
+	require.True(t, ok)
+	require.Equal(t, "request-id", inReplyTo)
+	correlationID, ok := extensions.GetCorrelationID(reply)
+	require.True(t, ok)
+	require.Equal(t, "correlation-id", correlationID)
+
+	// no correlation id to copy
+	request = event.New()
+	request.SetID("other-id")
+	request.SetSource("/requester")
+	request.SetType("com.example.request")
+	reply = extensions.NewReply(request, "/responder", "com.example.response")
+	_, ok = extensions.GetCorrelationID(reply)
+	require.False(t, ok)
+}
@@ -0,0 +1,53 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package extensions
+
+import (
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/types"
+)
+
+const (
+	// IngressTimeExtension is the extension holding the time an event was received by the SDK.
+	IngressTimeExtension = "ingresstime"
+)
+
+// SetIngressTime sets the ingresstime extension of the event to t.
+func SetIngressTime(e event.EventWriter, t time.Time) {
+	e.SetExtension(IngressTimeExtension, t)
+}
+
+// GetIngressTime returns the ingresstime extension of the event, if set and valid.
+func GetIngressTime(e event.Event) (time.Time, bool) {
+	if v, ok := e.Extensions()[IngressTimeExtension]; ok {
+		if t, err := types.ToTime(v); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// Latency returns the end-to-end latency of the event at the given time,
+// i.e. now minus the event time. It returns false if the event has no time.
+func Latency(e event.Event, now time.Time) (time.Duration, bool) {
+	if e.Context == nil || e.Time().IsZero() {
+		return 0, false
+	}
+	return now.Sub(e.Time()), true
+}
+
+// IngressLatency returns the latency of the event when it was received,
+// i.e. its ingresstime minus the event time. It returns false if the event
+// has no time or no ingresstime.
+func IngressLatency(e event.Event) (time.Duration, bool) {
+	ingressTime, ok := GetIngressTime(e)
+	if !ok {
+		return 0, false
+	}
+	return Latency(e, ingressTime)
+}
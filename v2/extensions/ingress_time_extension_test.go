@@ -0,0 +1,53 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package extensions_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/extensions"
+)
+
+func TestIngressTime(t *testing.T) {
+	eventTime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	ingressTime := eventTime.Add(150 * time.Millisecond)
+
+	e := event.New()
+	_, ok := extensions.GetIngressTime(e)
+	require.False(t, ok)
+	_, ok = extensions.IngressLatency(e)
+	require.False(t, ok)
+	_, ok = extensions.Latency(e, ingressTime)
+	require.False(t, ok)
+
+	e.SetTime(eventTime)
+	extensions.SetIngressTime(&e, ingressTime)
+
+	got, ok := extensions.GetIngressTime(e)
+	require.True(t, ok)
+	require.True(t, ingressTime.Equal(got))
+
+	latency, ok := extensions.IngressLatency(e)
+	require.True(t, ok)
+	require.Equal(t, 150*time.Millisecond, latency)
+
+	latency, ok = extensions.Latency(e, eventTime.Add(time.Second))
+	require.True(t, ok)
+	require.Equal(t, time.Second, latency)
+}
+
+func TestIngressTime_FromString(t *testing.T) {
+	e := event.New()
+	e.SetExtension(extensions.IngressTimeExtension, "2026-01-02T03:04:05Z")
+
+	got, ok := extensions.GetIngressTime(e)
+	require.True(t, ok)
+	require.True(t, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC).Equal(got))
+}
@@ -0,0 +1,71 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package extensions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/types"
+)
+
+const (
+	// SeverityExtension is the extension holding the severity of log-style events.
+	SeverityExtension = "severity"
+)
+
+// Severity is the level of a log-style event, carried by the severity extension.
+type Severity int
+
+const (
+	SeverityDebug Severity = iota
+	SeverityInfo
+	SeverityWarn
+	SeverityError
+)
+
+var severityNames = []string{"debug", "info", "warn", "error"}
+
+// String returns the value of the severity extension for s.
+func (s Severity) String() string {
+	if s < SeverityDebug || s > SeverityError {
+		return fmt.Sprintf("Severity(%d)", int(s))
+	}
+	return severityNames[s]
+}
+
+// ParseSeverity parses the value of the severity extension, case-insensitively.
+// "warning" is accepted as an alias of "warn".
+func ParseSeverity(s string) (Severity, error) {
+	s = strings.ToLower(s)
+	if s == "warning" {
+		return SeverityWarn, nil
+	}
+	for i, name := range severityNames {
+		if s == name {
+			return Severity(i), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid severity %q, expected one of %s", s, strings.Join(severityNames, ", "))
+}
+
+// SetSeverity sets the severity extension of the event to s.
+func SetSeverity(e event.EventWriter, s Severity) {
+	e.SetExtension(SeverityExtension, s.String())
+}
+
+// GetSeverity returns the severity extension of the event, if set and valid.
+func GetSeverity(e event.Event) (Severity, bool) {
+	if v, ok := e.Extensions()[SeverityExtension]; ok {
+		if s, err := types.ToString(v); err == nil {
+			if severity, err := ParseSeverity(s); err == nil {
+				return severity, true
+			}
+		}
+	}
+	return 0, false
+}
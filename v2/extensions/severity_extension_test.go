@@ -0,0 +1,55 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package extensions_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/extensions"
+)
+
+func TestSeverity(t *testing.T) {
+	e := event.New()
+	_, ok := extensions.GetSeverity(e)
+	require.False(t, ok)
+
+	extensions.SetSeverity(&e, extensions.SeverityWarn)
+	require.Equal(t, "warn", e.Extensions()[extensions.SeverityExtension])
+	s, ok := extensions.GetSeverity(e)
+	require.True(t, ok)
+	require.Equal(t, extensions.SeverityWarn, s)
+
+	e.SetExtension(extensions.SeverityExtension, "critical")
+	_, ok = extensions.GetSeverity(e)
+	require.False(t, ok)
+}
+
+func TestParseSeverity(t *testing.T) {
+	for in, want := range map[string]extensions.Severity{
+		"debug":   extensions.SeverityDebug,
+		"INFO":    extensions.SeverityInfo,
+		"warn":    extensions.SeverityWarn,
+		"Warning": extensions.SeverityWarn,
+		"error":   extensions.SeverityError,
+	} {
+		got, err := extensions.ParseSeverity(in)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+		require.Equal(t, got, mustParse(t, got.String()))
+	}
+	_, err := extensions.ParseSeverity("fatal")
+	require.Error(t, err)
+	require.Equal(t, "Severity(7)", extensions.Severity(7).String())
+}
+
+func mustParse(t *testing.T, s string) extensions.Severity {
+	got, err := extensions.ParseSeverity(s)
+	require.NoError(t, err)
+	return got
+}
@@ -5,5 +5,8 @@
 
 /*
 Package gochan implements the CloudEvent transport implementation using go chan.
+
+The messages sent are received in the same process, without any server, which
+makes it suitable to test the code using a client.Client, see the example of New.
 */
 package gochan
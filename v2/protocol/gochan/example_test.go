@@ -0,0 +1,51 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package gochan_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudevents/sdk-go/v2/client"
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+	"github.com/cloudevents/sdk-go/v2/protocol/gochan"
+)
+
+func ExampleNew() {
+	// The same client sends and receives through the channel, without any
+	// server, e.g. to test the handlers.
+	c, err := client.New(gochan.New())
+	if err != nil {
+		panic(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- c.StartReceiver(ctx, func(e event.Event) {
+			var data map[string]string
+			_ = e.DataAs(&data)
+			fmt.Println(e.Type(), e.Source(), data["message"])
+			cancel()
+		})
+	}()
+
+	e := event.New()
+	e.SetID("1")
+	e.SetType("example.greeted")
+	e.SetSource("/example")
+	_ = e.SetData(event.ApplicationJSON, map[string]string{"message": "hello"})
+	if result := c.Send(context.Background(), e); !protocol.IsACK(result) {
+		panic(result)
+	}
+
+	if err := <-done; err != nil {
+		panic(err)
+	}
+	// Output:
+	// example.greeted /example hello
+}
@@ -117,10 +117,9 @@ func (p *Protocol) validateOrigin(ro string) (string, bool) {
 		if ao == "*" {
 			return ao, true
 		}
-		// TODO: it is not clear what the rules for allowed hosts are.
-		// Need to find docs for this. For now, test for prefix.
-		if strings.HasPrefix(ro, ao) {
-			return ao, true
+		// Origins are host names, hence compared case-insensitively.
+		if ro != "" && strings.EqualFold(ro, ao) {
+			return ro, true
 		}
 	}
 
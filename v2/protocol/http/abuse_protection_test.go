@@ -0,0 +1,100 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptionsHandler(t *testing.T) {
+	testCases := map[string]struct {
+		origins    []string
+		reqHeaders map[string]string
+		wantStatus int
+		wantHeader map[string]string
+	}{
+		"wildcard": {
+			origins:    []string{"*"},
+			reqHeaders: map[string]string{"WebHook-Request-Origin": "eventemitter.example.com"},
+			wantStatus: http.StatusOK,
+			wantHeader: map[string]string{
+				"WebHook-Allowed-Origin": "*",
+				"WebHook-Allowed-Rate":   "100",
+				"Allow":                  "POST, PUT",
+			},
+		},
+		"allowed origin is echoed": {
+			origins:    []string{"EventEmitter.example.com"},
+			reqHeaders: map[string]string{"WebHook-Request-Origin": "eventemitter.example.com"},
+			wantStatus: http.StatusOK,
+			wantHeader: map[string]string{
+				"WebHook-Allowed-Origin": "eventemitter.example.com",
+			},
+		},
+		"prefix of the origin not allowed": {
+			origins:    []string{"eventemitter"},
+			reqHeaders: map[string]string{"WebHook-Request-Origin": "eventemitter.attacker.com"},
+			wantStatus: http.StatusBadRequest,
+			wantHeader: map[string]string{
+				"WebHook-Allowed-Origin": "",
+			},
+		},
+		"request rate": {
+			origins: []string{"*"},
+			reqHeaders: map[string]string{
+				"WebHook-Request-Origin": "eventemitter.example.com",
+				"WebHook-Request-Rate":   "120",
+			},
+			wantStatus: http.StatusOK,
+			wantHeader: map[string]string{
+				"WebHook-Allowed-Rate": "100",
+			},
+		},
+		"origin not allowed": {
+			origins:    []string{"other"},
+			reqHeaders: map[string]string{"WebHook-Request-Origin": "eventemitter.example.com"},
+			wantStatus: http.StatusBadRequest,
+			wantHeader: map[string]string{
+				"WebHook-Allowed-Origin": "",
+			},
+		},
+		"missing origin": {
+			origins:    []string{"eventemitter"},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			p, err := New(WithDefaultOptionsHandlerFunc([]string{http.MethodPost, http.MethodPut}, 100, tc.origins, false))
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodOptions, "http://localhost", nil)
+			for k, v := range tc.reqHeaders {
+				req.Header.Set(k, v)
+			}
+			rw := httptest.NewRecorder()
+			p.ServeHTTP(rw, req)
+
+			require.Equal(t, tc.wantStatus, rw.Code)
+			for k, v := range tc.wantHeader {
+				require.Equal(t, v, rw.Header().Get(k), k)
+			}
+		})
+	}
+}
+
+func TestOptionsHandler_NotConfigured(t *testing.T) {
+	p, err := New()
+	require.NoError(t, err)
+
+	rw := httptest.NewRecorder()
+	p.OptionsHandler(rw, httptest.NewRequest(http.MethodOptions, "http://localhost", nil))
+	require.Equal(t, http.StatusMethodNotAllowed, rw.Code)
+}
@@ -0,0 +1,106 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+
+	cecontext "github.com/cloudevents/sdk-go/v2/context"
+)
+
+// BatchCollision is a (source, id) pair shared by more than one event of a batch.
+type BatchCollision struct {
+	Source string
+	ID     string
+	// Indexes are the positions of the colliding events in the batch.
+	Indexes []int
+}
+
+// BatchCollisionError is returned when a batch contains events with the same identity.
+type BatchCollisionError struct {
+	Collisions []BatchCollision
+}
+
+func (e *BatchCollisionError) Error() string {
+	collisions := make([]string, 0, len(e.Collisions))
+	for _, c := range e.Collisions {
+		collisions = append(collisions, fmt.Sprintf("(source=%q, id=%q) at %v", c.Source, c.ID, c.Indexes))
+	}
+	return "duplicate event identities in batch: " + strings.Join(collisions, ", ")
+}
+
+// BatchCollisionDetector checks the events of a batch before they are encoded.
+// It returns an error describing the collisions, or nil if there are none.
+type BatchCollisionDetector func(events []event.Event) error
+
+// DetectBatchCollisions is the default BatchCollisionDetector: it returns a
+// *BatchCollisionError listing the (source, id) pairs used by more than one event.
+func DetectBatchCollisions(events []event.Event) error {
+	type identity struct{ source, id string }
+	indexes := make(map[identity][]int, len(events))
+	var order []identity
+	for i, e := range events {
+		k := identity{source: e.Source(), id: e.ID()}
+		if _, ok := indexes[k]; !ok {
+			order = append(order, k)
+		}
+		indexes[k] = append(indexes[k], i)
+	}
+
+	var collisions []BatchCollision
+	for _, k := range order {
+		if len(indexes[k]) > 1 {
+			collisions = append(collisions, BatchCollision{Source: k.source, ID: k.id, Indexes: indexes[k]})
+		}
+	}
+	if len(collisions) == 0 {
+		return nil
+	}
+	return &BatchCollisionError{Collisions: collisions}
+}
+
+type batchCollisionsKey int
+
+const (
+	batchCollisionDetectorKey batchCollisionsKey = iota
+	batchCollisionsWarnOnlyKey
+)
+
+// WithBatchCollisionDetector returns a new context which makes NewHTTPRequestFromEvents
+// use the provided detector in place of DetectBatchCollisions.
+// A nil detector disables the check.
+func WithBatchCollisionDetector(ctx context.Context, detector BatchCollisionDetector) context.Context {
+	return context.WithValue(ctx, batchCollisionDetectorKey, detector)
+}
+
+// WithBatchCollisionsWarnOnly returns a new context which makes NewHTTPRequestFromEvents
+// log the collisions found in the batch as a warning, instead of failing.
+func WithBatchCollisionsWarnOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, batchCollisionsWarnOnlyKey, true)
+}
+
+func checkBatchCollisions(ctx context.Context, events []event.Event) error {
+	detector := DetectBatchCollisions
+	if v := ctx.Value(batchCollisionDetectorKey); v != nil {
+		detector = v.(BatchCollisionDetector)
+	}
+	if detector == nil {
+		return nil
+	}
+	err := detector(events)
+	if err == nil {
+		return nil
+	}
+	if warnOnly, _ := ctx.Value(batchCollisionsWarnOnlyKey).(bool); warnOnly {
+		cecontext.LoggerFrom(ctx).Warn(err.Error())
+		return nil
+	}
+	return err
+}
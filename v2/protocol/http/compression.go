@@ -0,0 +1,79 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package http
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	contentEncoding = "Content-Encoding"
+	encodingGzip    = "gzip"
+)
+
+// gzipRequestBody compresses the body of req, if any, and sets its
+// Content-Encoding header to gzip. An empty body is left as is.
+func gzipRequestBody(req *http.Request) error {
+	if req.Body == nil {
+		return nil
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return err
+	}
+	_ = req.Body.Close()
+	if len(body) == 0 {
+		return (*httpRequestWriter)(req).setBody(bytes.NewReader(body))
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(body); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+	req.Header.Set(contentEncoding, encodingGzip)
+	return (*httpRequestWriter)(req).setBody(&buf)
+}
+
+// gunzipRequestBody decompresses the body of req when its Content-Encoding
+// header is gzip, and removes the header. The whole body is decompressed, so a
+// malformed gzip stream is reported before the message is received.
+func gunzipRequestBody(req *http.Request) error {
+	if !strings.EqualFold(strings.TrimSpace(req.Header.Get(contentEncoding)), encodingGzip) {
+		return nil
+	}
+	req.Header.Del(contentEncoding)
+	if req.Body == nil {
+		return nil
+	}
+	compressed, err := io.ReadAll(req.Body)
+	if err != nil {
+		return err
+	}
+	_ = req.Body.Close()
+
+	var body []byte
+	if len(compressed) > 0 {
+		zr, err := gzip.NewReader(bytes.NewReader(compressed))
+		if err != nil {
+			return fmt.Errorf("cannot decode the gzip body: %w", err)
+		}
+		if body, err = io.ReadAll(zr); err != nil {
+			return fmt.Errorf("cannot decode the gzip body: %w", err)
+		}
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	return nil
+}
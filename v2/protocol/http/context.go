@@ -46,3 +46,53 @@ func RequestDataFromContext(ctx context.Context) *RequestData {
 	}
 	return nil
 }
+
+type rawRequestKey struct{}
+
+// WithRequestAtContext adds r to the Context, without its body, which is
+// read to decode the event. The Protocol adds the received requests to the
+// Context of their messages, so that the handlers can inspect them, e.g. the
+// TLS state, with RequestFromContext.
+func WithRequestAtContext(ctx context.Context, r *nethttp.Request) context.Context {
+	if r == nil {
+		return ctx
+	}
+	req := *r
+	req.Body = nethttp.NoBody
+	req.GetBody = nil
+	return context.WithValue(ctx, rawRequestKey{}, &req)
+}
+
+// RequestFromContext retrieves the *http.Request added to the Context by
+// WithRequestAtContext. Its body is empty. If not set nil is returned.
+func RequestFromContext(ctx context.Context) *nethttp.Request {
+	if req, ok := ctx.Value(rawRequestKey{}).(*nethttp.Request); ok {
+		return req
+	}
+	return nil
+}
+
+type responseHandlerKey struct{}
+
+// ResponseData holds the http.Response information subset of the response to
+// an event sent with Protocol.Send.
+type ResponseData struct {
+	StatusCode int
+	Header     nethttp.Header
+	Body       []byte
+}
+
+// WithResponseHandler returns a new context which makes Protocol.Send invoke fn
+// with the response to the event, e.g. to tell a 200 from a 202 or to read the
+// body of the response. The status code is also reported by the *Result
+// returned by Send. fn is not invoked if no response was received.
+func WithResponseHandler(ctx context.Context, fn func(*ResponseData)) context.Context {
+	return context.WithValue(ctx, responseHandlerKey{}, fn)
+}
+
+func responseHandlerFrom(ctx context.Context) func(*ResponseData) {
+	if fn, ok := ctx.Value(responseHandlerKey{}).(func(*ResponseData)); ok {
+		return fn
+	}
+	return nil
+}
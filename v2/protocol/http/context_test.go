@@ -7,11 +7,14 @@ package http
 
 import (
 	"context"
+	"io"
 	nethttp "net/http"
 	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -124,3 +127,23 @@ func newURL(u string) *url.URL {
 	}
 	return parsed
 }
+
+func TestWithRequestAtContext(t *testing.T) {
+	req := newRequest("http://testhost:8080/test/path.json", requestOptionAddHeader("key", "value"))
+	req.Body = io.NopCloser(strings.NewReader("body"))
+
+	got := RequestFromContext(WithRequestAtContext(context.TODO(), req))
+	require.NotNil(t, got)
+	assert.Equal(t, "value", got.Header.Get("key"))
+	assert.Equal(t, req.URL, got.URL)
+	assert.Equal(t, nethttp.NoBody, got.Body)
+	assert.Nil(t, got.GetBody)
+
+	// The body of the request is left untouched
+	body, err := io.ReadAll(req.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "body", string(body))
+
+	assert.Nil(t, RequestFromContext(WithRequestAtContext(context.TODO(), nil)))
+	assert.Nil(t, RequestFromContext(context.TODO()))
+}
@@ -0,0 +1,39 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package http
+
+import (
+	"net/http"
+	"strings"
+)
+
+// HeaderCasing is the casing of the names of the Ce-* headers written by the
+// Protocol to carry the attributes and extensions of the events in binary mode.
+type HeaderCasing int
+
+const (
+	// HeaderCasingCanonical writes the headers with the canonical MIME header
+	// casing of Go, e.g. Ce-Id. This is the default.
+	HeaderCasingCanonical HeaderCasing = iota
+	// HeaderCasingLowercase writes the headers lower case, e.g. ce-id, for
+	// case-sensitive proxies.
+	HeaderCasingLowercase
+)
+
+// applyHeaderCasing renames the Ce-* headers of header to the casing.
+// http.Header.Get can't find the renamed headers, so it's applied right
+// before the request is sent.
+func applyHeaderCasing(header http.Header, casing HeaderCasing) {
+	if casing != HeaderCasingLowercase {
+		return
+	}
+	for k, v := range header {
+		if lk := strings.ToLower(k); lk != k && strings.HasPrefix(lk, strings.ToLower(prefix)) {
+			delete(header, k)
+			header[lk] = append(header[lk], v...)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/binding/buffering"
+)
+
+// writeRequestWithHeaderSizeLimit writes m to req like WriteRequest, checking
+// the size of the resulting headers against the limit configured with WithHeaderSizeLimit.
+func (p *Protocol) writeRequestWithHeaderSizeLimit(ctx context.Context, m binding.Message, req *http.Request, transformers ...binding.Transformer) error {
+	if !p.headerSizeFallback {
+		if err := WriteRequest(ctx, m, req, transformers...); err != nil {
+			return err
+		}
+		if size := headerSize(req.Header); size > p.headerSizeLimit {
+			return fmt.Errorf("the request headers size of %d bytes exceeds the limit of %d bytes", size, p.headerSizeLimit)
+		}
+		return nil
+	}
+
+	// The message might be written twice, so it must be readable more than once
+	copied, err := buffering.CopyMessage(ctx, m, transformers...)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = copied.Finish(nil) }()
+
+	header := req.Header.Clone()
+	if err := WriteRequest(ctx, copied, req); err != nil {
+		return err
+	}
+	if headerSize(req.Header) <= p.headerSizeLimit {
+		return nil
+	}
+	// The structured encoding moves the attributes and extensions to the body
+	req.Header = header
+	return WriteRequest(binding.WithForceStructured(ctx), copied, req)
+}
+
+// headerSize returns the size of header as sent on the wire by HTTP/1.1.
+func headerSize(header http.Header) int {
+	size := 0
+	for k, vs := range header {
+		for _, v := range vs {
+			size += len(k) + len(v) + len(": \r\n")
+		}
+	}
+	return size
+}
@@ -0,0 +1,76 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+	"github.com/cloudevents/sdk-go/v2/test"
+)
+
+func TestWithHeaderSizeLimit(t *testing.T) {
+	oversized := test.MinEvent()
+	oversized.SetExtension("large", strings.Repeat("x", 2048))
+	small := test.MinEvent()
+
+	testCases := map[string]struct {
+		event           event.Event
+		fallback        bool
+		wantContentType string
+		wantErr         string
+	}{
+		"small headers": {
+			event: small,
+		},
+		"oversized headers": {
+			event:   oversized,
+			wantErr: "exceeds the limit of 1024 bytes",
+		},
+		"oversized headers with fallback": {
+			event:           oversized,
+			fallback:        true,
+			wantContentType: event.ApplicationCloudEventsJSON,
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			var got *http.Request
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = r
+				w.WriteHeader(http.StatusAccepted)
+			}))
+			defer server.Close()
+
+			p, err := New(WithTarget(server.URL), WithHeaderSizeLimit(1024, tc.fallback))
+			require.NoError(t, err)
+
+			err = p.Send(context.Background(), binding.ToMessage(&tc.event))
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				require.Nil(t, got)
+				return
+			}
+			require.True(t, protocol.IsACK(err), "unexpected result: %v", err)
+			require.NotNil(t, got)
+			require.Equal(t, tc.wantContentType, got.Header.Get(ContentType))
+			require.LessOrEqual(t, headerSize(got.Header), 1024)
+		})
+	}
+}
+
+func TestWithHeaderSizeLimit_Invalid(t *testing.T) {
+	_, err := New(WithHeaderSizeLimit(0, true))
+	require.Error(t, err)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/cloudevents/sdk-go/v2/binding"
 	"net/http"
 	"net/textproto"
+	"sort"
 	"strings"
 	"unicode"
 
@@ -53,3 +54,20 @@ func HeaderFrom(ctx context.Context) http.Header {
 func WithCustomHeader(ctx context.Context, header http.Header) context.Context {
 	return context.WithValue(ctx, headerKey, header)
 }
+
+// EventHeaderNames returns the sorted names of the headers of h carrying the
+// event in binary mode, i.e. the Ce-* headers and Content-Type. net/http writes
+// the headers sorted by name, so this is also the order they are sent in.
+// Signers and verifiers of HTTP message signatures can use it as the list of
+// headers to cover, to sign the event attributes and extensions.
+func EventHeaderNames(h http.Header) []string {
+	names := make([]string, 0, len(h))
+	for k := range h {
+		ck := textproto.CanonicalMIMEHeaderKey(k)
+		if ck == ContentType || strings.HasPrefix(ck, prefix) {
+			names = append(names, ck)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -6,10 +6,17 @@
 package http
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/event"
 )
 
 func TestHeaderFrom(t *testing.T) {
@@ -44,3 +51,50 @@ func TestHeaderFrom(t *testing.T) {
 		})
 	}
 }
+
+func TestEventHeaderNames(t *testing.T) {
+	e := event.New()
+	e.SetID("id")
+	e.SetSource("source")
+	e.SetType("type")
+	e.SetExtension("zeta", "z")
+	e.SetExtension("alpha", "a")
+	e.SetExtension("mu", "m")
+	if err := e.SetData(event.TextPlain, "hello"); err != nil {
+		t.Fatal(err)
+	}
+
+	var wire []string
+	for i := 0; i < 10; i++ {
+		req := httptest.NewRequest("POST", "http://localhost", nil)
+		req.Header.Set("X-Other", "other")
+		if err := WriteRequest(binding.WithForceBinary(context.Background()), binding.ToMessage(&e), req); err != nil {
+			t.Fatal(err)
+		}
+
+		want := []string{"Ce-Alpha", "Ce-Id", "Ce-Mu", "Ce-Source", "Ce-Specversion", "Ce-Type", "Ce-Zeta", "Content-Type"}
+		if got := EventHeaderNames(req.Header); !reflect.DeepEqual(got, want) {
+			t.Fatalf("EventHeaderNames() = %v, want %v", got, want)
+		}
+
+		// The headers are sent in the same order
+		var buf bytes.Buffer
+		if err := req.Header.Write(&buf); err != nil {
+			t.Fatal(err)
+		}
+		var sent []string
+		scanner := bufio.NewScanner(&buf)
+		for scanner.Scan() {
+			if name, _, ok := strings.Cut(scanner.Text(), ":"); ok && name != "X-Other" {
+				sent = append(sent, name)
+			}
+		}
+		if !reflect.DeepEqual(sent, want) {
+			t.Fatalf("headers sent in order %v, want %v", sent, want)
+		}
+		if wire != nil && !reflect.DeepEqual(sent, wire) {
+			t.Fatalf("headers order changed: %v, then %v", wire, sent)
+		}
+		wire = sent
+	}
+}
@@ -8,11 +8,14 @@ package http
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -21,6 +24,7 @@ import (
 	bindingtest "github.com/cloudevents/sdk-go/v2/binding/test"
 	"github.com/cloudevents/sdk-go/v2/binding/transformer"
 	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/extensions"
 	"github.com/cloudevents/sdk-go/v2/test"
 )
 
@@ -193,3 +197,380 @@ func TestNewMessageFromHttpResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestTranscodeDataContentEncoding(t *testing.T) {
+	data := []byte{0x00, 0x01, 0x02, 0xff}
+	transcode := func(t *testing.T, ctx context.Context, in *http.Request) *http.Request {
+		out := httptest.NewRequest("POST", "http://localhost", nil)
+		require.NoError(t, WriteRequest(ctx, NewMessageFromHttpRequest(in), out))
+		body, err := io.ReadAll(out.Body)
+		require.NoError(t, err)
+		out.Body = io.NopCloser(bytes.NewReader(body))
+		return out
+	}
+	structuredBody := func(t *testing.T, req *http.Request) map[string]interface{} {
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		var m map[string]interface{}
+		require.NoError(t, json.Unmarshal(body, &m))
+		return m
+	}
+
+	t.Run("structured to binary to structured", func(t *testing.T) {
+		in := httptest.NewRequest("POST", "http://localhost", bytes.NewReader([]byte(
+			`{"specversion":"0.3","id":"id","source":"source","type":"type","datacontenttype":"application/octet-stream","datacontentencoding":"base64","data":"AAEC/w=="}`)))
+		in.Header.Set(ContentType, event.ApplicationCloudEventsJSON)
+
+		binary := transcode(t, binding.WithForceBinary(context.Background()), in)
+		require.Equal(t, "application/octet-stream", binary.Header.Get(ContentType))
+		body, err := io.ReadAll(binary.Body)
+		require.NoError(t, err)
+		require.Equal(t, data, body)
+		binary.Body = io.NopCloser(bytes.NewReader(body))
+
+		structured := transcode(t, binding.WithForceStructured(context.Background()), binary)
+		m := structuredBody(t, structured)
+		require.Equal(t, "base64", m["datacontentencoding"])
+		require.Equal(t, "AAEC/w==", m["data"])
+
+		e, err := NewEventFromHTTPRequest(structured)
+		require.NoError(t, err)
+		require.Equal(t, data, e.Data())
+	})
+
+	t.Run("binary without datacontentencoding", func(t *testing.T) {
+		in := httptest.NewRequest("POST", "http://localhost", bytes.NewReader(data))
+		in.Header.Set("ce-specversion", "0.3")
+		in.Header.Set("ce-id", "id")
+		in.Header.Set("ce-source", "source")
+		in.Header.Set("ce-type", "type")
+		in.Header.Set(ContentType, "application/octet-stream")
+
+		e, err := binding.ToEvent(context.Background(), NewMessageFromHttpRequest(in))
+		require.NoError(t, err)
+		require.Equal(t, "", e.DeprecatedDataContentEncoding())
+		require.Equal(t, data, e.Data())
+
+		in.Body = io.NopCloser(bytes.NewReader(data))
+		binary := transcode(t, binding.WithForceBinary(context.Background()), in)
+		require.Empty(t, binary.Header.Get("ce-datacontentencoding"))
+		body, err := io.ReadAll(binary.Body)
+		require.NoError(t, err)
+		require.Equal(t, data, body)
+	})
+
+	t.Run("invalid datacontentencoding", func(t *testing.T) {
+		in := httptest.NewRequest("POST", "http://localhost", bytes.NewReader(data))
+		in.Header.Set("ce-specversion", "0.3")
+		in.Header.Set("ce-id", "id")
+		in.Header.Set("ce-source", "source")
+		in.Header.Set("ce-type", "type")
+		in.Header.Set("ce-datacontentencoding", "gzip")
+		in.Header.Set(ContentType, "application/octet-stream")
+
+		e, err := binding.ToEvent(context.Background(), NewMessageFromHttpRequest(in))
+		require.Error(t, err)
+		require.Nil(t, e)
+	})
+
+	t.Run("binary with datacontentencoding to structured to binary", func(t *testing.T) {
+		in := httptest.NewRequest("POST", "http://localhost", bytes.NewReader([]byte("AAEC/w==")))
+		in.Header.Set("ce-specversion", "0.3")
+		in.Header.Set("ce-id", "id")
+		in.Header.Set("ce-source", "source")
+		in.Header.Set("ce-type", "type")
+		in.Header.Set("ce-datacontentencoding", "base64")
+		in.Header.Set(ContentType, "application/octet-stream")
+
+		structured := transcode(t, binding.WithForceStructured(context.Background()), in)
+		m := structuredBody(t, structured)
+		require.Equal(t, "base64", m["datacontentencoding"])
+		require.Equal(t, "AAEC/w==", m["data"])
+
+		binary := transcode(t, binding.WithForceBinary(context.Background()), structured)
+		body, err := io.ReadAll(binary.Body)
+		require.NoError(t, err)
+		require.Equal(t, data, body)
+	})
+}
+
+func TestSubjectRoundTrip(t *testing.T) {
+	newEvent := func(version string, extensions map[string]interface{}) event.Event {
+		e := event.New(version)
+		e.SetID("id")
+		e.SetSource("source")
+		e.SetType("type")
+		e.SetSubject("the/subject")
+		for k, v := range extensions {
+			e.SetExtension(k, v)
+		}
+		return e
+	}
+
+	for _, version := range []string{event.CloudEventsVersionV03, event.CloudEventsVersionV1} {
+		for name, extensions := range map[string]map[string]interface{}{
+			"only subject":            nil,
+			"subject with extensions": {"exta": "a", "extb": "b"},
+		} {
+			t.Run(version+" "+name, func(t *testing.T) {
+				e := newEvent(version, extensions)
+
+				binary := httptest.NewRequest("POST", "http://localhost", nil)
+				require.NoError(t, WriteRequest(binding.WithForceBinary(context.Background()), binding.ToMessage(&e), binary))
+				require.Equal(t, "the/subject", binary.Header.Get("Ce-Subject"))
+				for k := range extensions {
+					require.NotEmpty(t, binary.Header.Get("Ce-"+k))
+				}
+
+				structured := httptest.NewRequest("POST", "http://localhost", nil)
+				require.NoError(t, WriteRequest(binding.WithForceStructured(context.Background()), NewMessageFromHttpRequest(binary), structured))
+				body, err := io.ReadAll(structured.Body)
+				require.NoError(t, err)
+				var m map[string]interface{}
+				require.NoError(t, json.Unmarshal(body, &m))
+				require.Equal(t, "the/subject", m["subject"])
+				structured.Body = io.NopCloser(bytes.NewReader(body))
+
+				got, err := NewEventFromHTTPRequest(structured)
+				require.NoError(t, err)
+				require.Equal(t, "the/subject", got.Subject())
+				_, isExtension := got.Extensions()["subject"]
+				require.False(t, isExtension)
+				test.AssertEventEquals(t, e, *got)
+			})
+		}
+	}
+}
+
+func TestDataBytesPreserved(t *testing.T) {
+	// Not decoded in a map: the order of the members and the precision of the numbers are kept
+	data := `{"z":9223372036854775807,"a":[1.10,-0.0]}`
+
+	t.Run("structured", func(t *testing.T) {
+		in := httptest.NewRequest("POST", "http://localhost", bytes.NewReader([]byte(
+			`{"specversion":"1.0","id":"id","source":"source","type":"type","datacontenttype":"application/json","data":`+data+`}`)))
+		in.Header.Set(ContentType, event.ApplicationCloudEventsJSON)
+
+		e, err := NewEventFromHTTPRequest(in)
+		require.NoError(t, err)
+		require.Equal(t, data, string(e.Data()))
+
+		out, err := NewHTTPRequestFromEvent(binding.WithForceStructured(context.Background()), "http://localhost", *e)
+		require.NoError(t, err)
+		body, err := io.ReadAll(out.Body)
+		require.NoError(t, err)
+		require.Contains(t, string(body), `"data":`+data)
+	})
+
+	t.Run("binary", func(t *testing.T) {
+		in := httptest.NewRequest("POST", "http://localhost", bytes.NewReader([]byte(data)))
+		in.Header.Set("ce-specversion", "1.0")
+		in.Header.Set("ce-id", "id")
+		in.Header.Set("ce-source", "source")
+		in.Header.Set("ce-type", "type")
+		in.Header.Set(ContentType, event.ApplicationJSON)
+
+		e, err := NewEventFromHTTPRequest(in)
+		require.NoError(t, err)
+		require.Equal(t, data, string(e.Data()))
+
+		out, err := NewHTTPRequestFromEvent(binding.WithForceBinary(context.Background()), "http://localhost", *e)
+		require.NoError(t, err)
+		body, err := io.ReadAll(out.Body)
+		require.NoError(t, err)
+		require.Equal(t, data, string(body))
+	})
+}
+
+func TestContentTypeWithParameters(t *testing.T) {
+	t.Run("structured", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "http://localhost", bytes.NewReader([]byte(
+			`{"specversion":"1.0","id":"id","source":"source","type":"type","datacontenttype":"application/json; charset=utf-8","data":{"a":"b"}}`,
+		)))
+		req.Header.Set(ContentType, "application/cloudevents+json; charset=utf-8")
+
+		m := NewMessageFromHttpRequest(req)
+		require.Equal(t, binding.EncodingStructured, m.ReadEncoding())
+
+		e, err := binding.ToEvent(context.Background(), m)
+		require.NoError(t, err)
+		require.Equal(t, "application/json; charset=utf-8", e.DataContentType())
+		var data map[string]string
+		require.NoError(t, e.DataAs(&data))
+		require.Equal(t, map[string]string{"a": "b"}, data)
+	})
+
+	t.Run("binary", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "http://localhost", bytes.NewReader([]byte(`{"a":"b"}`)))
+		req.Header.Set("Ce-Specversion", "1.0")
+		req.Header.Set("Ce-Id", "id")
+		req.Header.Set("Ce-Source", "source")
+		req.Header.Set("Ce-Type", "type")
+		req.Header.Set(ContentType, "application/json; charset=utf-8")
+
+		m := NewMessageFromHttpRequest(req)
+		require.Equal(t, binding.EncodingBinary, m.ReadEncoding())
+
+		e, err := binding.ToEvent(context.Background(), m)
+		require.NoError(t, err)
+		require.Equal(t, "application/json; charset=utf-8", e.DataContentType())
+		var data map[string]string
+		require.NoError(t, e.DataAs(&data))
+		require.Equal(t, map[string]string{"a": "b"}, data)
+	})
+}
+
+func TestBinaryTimeHeader(t *testing.T) {
+	for _, tc := range []struct {
+		header string
+		want   time.Time
+	}{
+		{header: "2019-02-28T15:04:05Z", want: time.Date(2019, 2, 28, 15, 4, 5, 0, time.UTC)},
+		{header: "2019-02-28T15:04:05.123456789Z", want: time.Date(2019, 2, 28, 15, 4, 5, 123456789, time.UTC)},
+		{header: "2019-02-28T15:04:05+00:00", want: time.Date(2019, 2, 28, 15, 4, 5, 0, time.UTC)},
+		{header: "2019-02-28T15:04:05", want: time.Date(2019, 2, 28, 15, 4, 5, 0, time.UTC)},
+	} {
+		t.Run(tc.header, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "http://localhost", nil)
+			req.Header.Set("Ce-Specversion", "1.0")
+			req.Header.Set("Ce-Id", "id")
+			req.Header.Set("Ce-Source", "source")
+			req.Header.Set("Ce-Type", "type")
+			req.Header.Set("Ce-Time", tc.header)
+
+			e, err := binding.ToEvent(context.Background(), NewMessageFromHttpRequest(req))
+			require.NoError(t, err)
+			require.True(t, tc.want.Equal(e.Time()), "got %v, want %v", e.Time(), tc.want)
+
+			// The time is always written with RFC3339Nano in UTC
+			out := httptest.NewRequest("POST", "http://localhost", nil)
+			require.NoError(t, WriteRequest(binding.WithForceBinary(context.Background()), binding.ToMessage(e), out))
+			require.Equal(t, tc.want.Format(time.RFC3339Nano), out.Header.Get("Ce-Time"))
+		})
+	}
+}
+
+func TestDataSchemaRoundTrip(t *testing.T) {
+	for _, tc := range []struct {
+		version    string
+		header     string
+		otherKey   string
+		jsonMember string
+	}{
+		{version: event.CloudEventsVersionV1, header: "Ce-Dataschema", otherKey: "Ce-Schemaurl", jsonMember: "dataschema"},
+		{version: event.CloudEventsVersionV03, header: "Ce-Schemaurl", otherKey: "Ce-Dataschema", jsonMember: "schemaurl"},
+	} {
+		t.Run(tc.version, func(t *testing.T) {
+			e := event.New(tc.version)
+			e.SetID("id")
+			e.SetSource("source")
+			e.SetType("type")
+			e.SetDataSchema("http://example.com/schema")
+
+			binary := httptest.NewRequest("POST", "http://localhost", nil)
+			require.NoError(t, WriteRequest(binding.WithForceBinary(context.Background()), binding.ToMessage(&e), binary))
+			require.Equal(t, "http://example.com/schema", binary.Header.Get(tc.header))
+			require.Empty(t, binary.Header.Get(tc.otherKey))
+
+			structured := httptest.NewRequest("POST", "http://localhost", nil)
+			require.NoError(t, WriteRequest(binding.WithForceStructured(context.Background()), binding.ToMessage(&e), structured))
+			body, err := io.ReadAll(structured.Body)
+			require.NoError(t, err)
+			var members map[string]interface{}
+			require.NoError(t, json.Unmarshal(body, &members))
+			require.Equal(t, "http://example.com/schema", members[tc.jsonMember])
+			structured.Body = io.NopCloser(bytes.NewReader(body))
+
+			for name, req := range map[string]*http.Request{"binary": binary, "structured": structured} {
+				got, err := binding.ToEvent(context.Background(), NewMessageFromHttpRequest(req))
+				require.NoError(t, err, name)
+				require.Equal(t, "http://example.com/schema", got.DataSchema(), name)
+				require.Empty(t, got.Extensions(), name)
+			}
+		})
+	}
+
+	t.Run("schemaurl is not the 1.0 dataschema", func(t *testing.T) {
+		binary := httptest.NewRequest("POST", "http://localhost", nil)
+		binary.Header.Set("Ce-Specversion", "1.0")
+		binary.Header.Set("Ce-Id", "id")
+		binary.Header.Set("Ce-Source", "source")
+		binary.Header.Set("Ce-Type", "type")
+		binary.Header.Set("Ce-Schemaurl", "http://example.com/schema")
+
+		structured := httptest.NewRequest("POST", "http://localhost", bytes.NewReader([]byte(
+			`{"specversion":"1.0","id":"id","source":"source","type":"type","schemaurl":"http://example.com/schema"}`,
+		)))
+		structured.Header.Set(ContentType, event.ApplicationCloudEventsJSON)
+
+		for name, req := range map[string]*http.Request{"binary": binary, "structured": structured} {
+			got, err := binding.ToEvent(context.Background(), NewMessageFromHttpRequest(req))
+			require.NoError(t, err, name)
+			require.Empty(t, got.DataSchema(), name)
+			// it's an extension like any other unknown attribute
+			require.Equal(t, map[string]interface{}{"schemaurl": "http://example.com/schema"}, got.Extensions(), name)
+		}
+	})
+}
+
+func TestDistributedTracingRoundTrip(t *testing.T) {
+	dt := extensions.DistributedTracingExtension{
+		TraceParent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		TraceState:  "rojo=00f067aa0ba902b7,congo=t61rcWkgMzE",
+	}
+	e := event.New()
+	e.SetID("id")
+	e.SetSource("source")
+	e.SetType("type")
+	dt.AddTracingAttributes(&e)
+
+	binary := httptest.NewRequest("POST", "http://localhost", nil)
+	require.NoError(t, WriteRequest(binding.WithForceBinary(context.Background()), binding.ToMessage(&e), binary))
+	require.Equal(t, dt.TraceParent, binary.Header.Get("Ce-Traceparent"))
+	require.Equal(t, dt.TraceState, binary.Header.Get("Ce-Tracestate"))
+
+	got, err := NewEventFromHTTPRequest(binary)
+	require.NoError(t, err)
+	gotDt, ok := extensions.GetDistributedTracingExtension(*got)
+	require.True(t, ok)
+	require.Equal(t, dt, gotDt)
+}
+
+func TestStructuredBinaryDataRoundTrip(t *testing.T) {
+	data := make([]byte, 256)
+	for i := range data {
+		data[i] = byte(i)
+	}
+
+	for _, version := range []string{event.CloudEventsVersionV03, event.CloudEventsVersionV1} {
+		t.Run(version, func(t *testing.T) {
+			e := event.New(version)
+			e.SetID("id")
+			e.SetSource("source")
+			e.SetType("type")
+			require.NoError(t, e.SetData("application/protobuf", data))
+
+			req := httptest.NewRequest("POST", "http://localhost", nil)
+			require.NoError(t, WriteRequest(binding.WithForceStructured(context.Background()), binding.ToMessage(&e), req))
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			var m map[string]interface{}
+			require.NoError(t, json.Unmarshal(body, &m))
+			encoded := base64.StdEncoding.EncodeToString(data)
+			if version == event.CloudEventsVersionV1 {
+				require.NotContains(t, m, "data")
+				require.Equal(t, encoded, m["data_base64"])
+			} else {
+				require.Equal(t, "base64", m["datacontentencoding"])
+				require.Equal(t, encoded, m["data"])
+			}
+			req.Body = io.NopCloser(bytes.NewReader(body))
+
+			got, err := NewEventFromHTTPRequest(req)
+			require.NoError(t, err)
+			require.Equal(t, "application/protobuf", got.DataContentType())
+			require.Equal(t, data, got.Data())
+		})
+	}
+}
@@ -12,6 +12,8 @@ import (
 	"net/url"
 	"strings"
 	"time"
+
+	"github.com/cloudevents/sdk-go/v2/event"
 )
 
 // Option is the function signature required to be considered an http.Option.
@@ -240,7 +242,7 @@ func WithOptionsHandlerFunc(fn nethttp.HandlerFunc) Option {
 // WithDefaultOptionsHandlerFunc sets the options handler to be the built in handler and configures the options.
 // methods: the supported methods reported to OPTIONS caller.
 // rate: the rate limit reported to OPTIONS caller.
-// origins: the prefix of the accepted origins, or "*".
+// origins: the accepted origins, matched case-insensitively, or "*".
 // callback: preform the callback to ACK the OPTIONS request.
 func WithDefaultOptionsHandlerFunc(methods []string, rate int, origins []string, callback bool) Option {
 	return func(p *Protocol) error {
@@ -277,6 +279,28 @@ func WithIsRetriableFunc(isRetriable IsRetriable) Option {
 	}
 }
 
+// WithRetry retries the requests up to max times on network errors and on the
+// retriable status codes, see WithIsRetriableFunc, waiting backoff(tries) before
+// each retry, tries starting at 1, or the delay of the Retry-After header of the
+// response if present. The other status codes fail immediately. The retries
+// set on the context with the context.WithRetries* functions take precedence.
+func WithRetry(max int, backoff func(tries int) time.Duration) Option {
+	return func(p *Protocol) error {
+		if p == nil {
+			return fmt.Errorf("http retry option can not set nil protocol")
+		}
+		if max <= 0 {
+			return fmt.Errorf("http retry max must be positive, got %d", max)
+		}
+		if backoff == nil {
+			return fmt.Errorf("http retry backoff can not be nil")
+		}
+		p.retryMax = max
+		p.retryBackoffFn = backoff
+		return nil
+	}
+}
+
 func WithRateLimiter(rl RateLimiter) Option {
 	return func(p *Protocol) error {
 		if p == nil {
@@ -298,3 +322,88 @@ func WithRequestDataAtContextMiddleware() Option {
 		})
 	})
 }
+
+// WithHeaderSizeLimit limits the size of the headers of the outbound requests
+// to limit bytes, as servers usually reject the requests with large headers,
+// e.g. with 431 Request Header Fields Too Large. The events encoded in binary
+// mode carry their attributes and extensions in the headers: if the headers
+// exceed the limit, the event is sent in structured mode when fallbackStructured
+// is true, otherwise sending fails with an error.
+func WithHeaderSizeLimit(limit int, fallbackStructured bool) Option {
+	return func(p *Protocol) error {
+		if p == nil {
+			return fmt.Errorf("http header size limit option can not set nil protocol")
+		}
+		if limit <= 0 {
+			return fmt.Errorf("http header size limit must be positive, got %d", limit)
+		}
+		p.headerSizeLimit = limit
+		p.headerSizeFallback = fallbackStructured
+		return nil
+	}
+}
+
+// WithDefaultSendTimeout bounds Send to timeout, including its retries, when
+// the context passed to Send has no deadline. The deadline of the context, if
+// any, is left untouched. Request is not bounded, since the response body is
+// read after it returns.
+func WithDefaultSendTimeout(timeout time.Duration) Option {
+	return func(p *Protocol) error {
+		if p == nil {
+			return fmt.Errorf("http default send timeout option can not set nil protocol")
+		}
+		if timeout <= 0 {
+			return fmt.Errorf("http default send timeout must be positive, got %v", timeout)
+		}
+		p.defaultSendTimeout = timeout
+		return nil
+	}
+}
+
+// WithHeaderCasing sets the casing of the names of the Ce-* headers of the
+// outbound requests, see HeaderCasing. Inbound headers are matched regardless
+// of their casing.
+func WithHeaderCasing(casing HeaderCasing) Option {
+	return func(p *Protocol) error {
+		if p == nil {
+			return fmt.Errorf("http header casing option can not set nil protocol")
+		}
+		if casing != HeaderCasingCanonical && casing != HeaderCasingLowercase {
+			return fmt.Errorf("http header casing %d is unknown", casing)
+		}
+		p.headerCasing = casing
+		return nil
+	}
+}
+
+// WithGzip compresses the body of the outbound requests with gzip, setting
+// their Content-Encoding header, unless the body is empty. The inbound requests
+// with a gzip Content-Encoding are decompressed before being received; the ones
+// with a malformed gzip body are rejected with 400 Bad Request.
+func WithGzip() Option {
+	return func(p *Protocol) error {
+		if p == nil {
+			return fmt.Errorf("http gzip option can not set nil protocol")
+		}
+		p.gzip = true
+		return nil
+	}
+}
+
+// WithURLTransform sets fn to compute the URL of the outbound request of each
+// event, e.g. a path derived from its type. The URL returned by fn is resolved
+// against the target, so it can be either absolute or relative to the target,
+// e.g. "/events/" + e.Type(). If fn returns an empty string, the target is used.
+// fn is given the event before the transformers passed to Send are applied.
+func WithURLTransform(fn func(event.Event) string) Option {
+	return func(p *Protocol) error {
+		if p == nil {
+			return fmt.Errorf("http url transform option can not set nil protocol")
+		}
+		if fn == nil {
+			return fmt.Errorf("http url transform can not be nil")
+		}
+		p.urlTransform = fn
+		return nil
+	}
+}
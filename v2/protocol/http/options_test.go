@@ -17,6 +17,8 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/event"
 )
 
 func TestWithTarget(t *testing.T) {
@@ -817,3 +819,52 @@ type mockOptionsServer struct {
 func (m mockOptionsServer) ServeHTTP(res http.ResponseWriter, req *http.Request) {
 	m.handler(res, req)
 }
+
+func TestWithDefaultSendTimeout(t *testing.T) {
+	p := &Protocol{}
+	require.NoError(t, p.applyOptions(WithDefaultSendTimeout(time.Second)))
+	require.Equal(t, time.Second, p.defaultSendTimeout)
+
+	require.EqualError(t, p.applyOptions(WithDefaultSendTimeout(0)), "http default send timeout must be positive, got 0s")
+	require.EqualError(t, (*Protocol)(nil).applyOptions(WithDefaultSendTimeout(time.Second)), "http default send timeout option can not set nil protocol")
+}
+
+func TestWithHeaderCasing(t *testing.T) {
+	p := &Protocol{}
+	require.NoError(t, p.applyOptions(WithHeaderCasing(HeaderCasingLowercase)))
+	require.Equal(t, HeaderCasingLowercase, p.headerCasing)
+
+	require.EqualError(t, p.applyOptions(WithHeaderCasing(HeaderCasing(42))), "http header casing 42 is unknown")
+	require.EqualError(t, (*Protocol)(nil).applyOptions(WithHeaderCasing(HeaderCasingLowercase)), "http header casing option can not set nil protocol")
+}
+
+func TestWithRetry(t *testing.T) {
+	backoff := func(int) time.Duration { return time.Second }
+	p := &Protocol{}
+	require.NoError(t, p.applyOptions(WithRetry(3, backoff)))
+	require.Equal(t, 3, p.retryMax)
+	require.Equal(t, time.Second, p.retryBackoffFn(1))
+
+	require.EqualError(t, p.applyOptions(WithRetry(0, backoff)), "http retry max must be positive, got 0")
+	require.EqualError(t, p.applyOptions(WithRetry(3, nil)), "http retry backoff can not be nil")
+	require.EqualError(t, (*Protocol)(nil).applyOptions(WithRetry(3, backoff)), "http retry option can not set nil protocol")
+}
+
+func TestWithGzip(t *testing.T) {
+	p := &Protocol{}
+	require.NoError(t, p.applyOptions(WithGzip()))
+	require.True(t, p.gzip)
+
+	require.EqualError(t, (*Protocol)(nil).applyOptions(WithGzip()), "http gzip option can not set nil protocol")
+}
+
+func TestWithURLTransform(t *testing.T) {
+	p := &Protocol{}
+	require.NoError(t, p.applyOptions(WithURLTransform(func(e event.Event) string { return "/" + e.Type() })))
+	e := event.New()
+	e.SetType("type")
+	require.Equal(t, "/type", p.urlTransform(e))
+
+	require.EqualError(t, p.applyOptions(WithURLTransform(nil)), "http url transform can not be nil")
+	require.EqualError(t, (*Protocol)(nil).applyOptions(WithURLTransform(func(event.Event) string { return "" })), "http url transform option can not set nil protocol")
+}
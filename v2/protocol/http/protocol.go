@@ -14,6 +14,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -90,6 +91,19 @@ type Protocol struct {
 	limiter           RateLimiter
 
 	isRetriableFunc IsRetriable
+	retryMax        int
+	retryBackoffFn  func(tries int) time.Duration
+
+	headerSizeLimit    int
+	headerSizeFallback bool
+
+	defaultSendTimeout time.Duration
+
+	headerCasing HeaderCasing
+
+	gzip bool
+
+	urlTransform func(event.Event) string
 }
 
 func New(opts ...Option) (*Protocol, error) {
@@ -149,10 +163,29 @@ func (p *Protocol) Send(ctx context.Context, m binding.Message, transformers ...
 		return fmt.Errorf("nil Message")
 	}
 
+	if _, ok := ctx.Deadline(); !ok && p.defaultSendTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.defaultSendTimeout)
+		defer cancel()
+	}
+
 	msg, err := p.Request(ctx, m, transformers...)
 	if msg != nil {
 		defer func() { _ = msg.Finish(err) }()
 	}
+	if fn := responseHandlerFrom(ctx); fn != nil {
+		var res *Result
+		if message, ok := msg.(*Message); ok && protocol.ResultAs(err, &res) {
+			var body []byte
+			if message.BodyReader != nil {
+				body, _ = io.ReadAll(message.BodyReader)
+				_ = message.BodyReader.Close()
+				// The body is read again below for the NACKs
+				message.BodyReader = io.NopCloser(bytes.NewReader(body))
+			}
+			fn(&ResponseData{StatusCode: res.StatusCode, Header: message.Header, Body: body})
+		}
+	}
 	if err != nil && !protocol.IsACK(err) {
 		var res *Result
 		if protocol.ResultAs(err, &res) {
@@ -191,9 +224,33 @@ func (p *Protocol) Request(ctx context.Context, m binding.Message, transformers
 		return nil, fmt.Errorf("not initialized: %#v", p)
 	}
 
-	if err = WriteRequest(ctx, m, req, transformers...); err != nil {
+	out := m
+	if p.urlTransform != nil {
+		var e *event.Event
+		if e, err = binding.ToEvent(ctx, m); err != nil {
+			return nil, err
+		}
+		if err = p.applyURLTransform(req, *e); err != nil {
+			return nil, err
+		}
+		// The message might not be readable twice
+		out = (*binding.EventMessage)(e)
+	}
+
+	if p.headerSizeLimit > 0 {
+		err = p.writeRequestWithHeaderSizeLimit(ctx, out, req, transformers...)
+	} else {
+		err = WriteRequest(ctx, out, req, transformers...)
+	}
+	if err != nil {
 		return nil, err
 	}
+	applyHeaderCasing(req.Header, p.headerCasing)
+	if p.gzip {
+		if err = gzipRequestBody(req); err != nil {
+			return nil, err
+		}
+	}
 
 	return p.do(ctx, req)
 }
@@ -223,6 +280,21 @@ func (p *Protocol) makeRequest(ctx context.Context) *http.Request {
 	return req.WithContext(ctx)
 }
 
+// applyURLTransform sets the URL of req to the one returned by the urlTransform
+// for e, resolved against the URL of req. An empty string keeps the URL of req.
+func (p *Protocol) applyURLTransform(req *http.Request, e event.Event) error {
+	target := strings.TrimSpace(p.urlTransform(e))
+	if target == "" {
+		return nil
+	}
+	u, err := url.Parse(target)
+	if err != nil {
+		return fmt.Errorf("http url transform returned an invalid url %q: %w", target, err)
+	}
+	req.URL = req.URL.ResolveReference(u)
+	return nil
+}
+
 // Ensure to is a non-nil map before copying
 func copyHeadersEnsure(from http.Header, to *http.Header) {
 	if len(from) > 0 {
@@ -334,6 +406,15 @@ func (p *Protocol) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	if p.gzip {
+		if err := gunzipRequestBody(req); err != nil {
+			p.incoming <- msgErr{msg: nil, err: err}
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	req = req.WithContext(WithRequestAtContext(req.Context(), req))
 	m := NewMessageFromHttpRequest(req)
 	if m == nil {
 		// Should never get here unless ServeHTTP is called directly.
@@ -342,6 +423,9 @@ func (p *Protocol) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		return // if there was no message, return.
 	}
 
+	// The response event is encoded in the content mode of the request
+	structured := m.ReadEncoding() == binding.EncodingStructured
+
 	var finishErr error
 	m.OnFinish = func(err error) error {
 		finishErr = err
@@ -363,8 +447,8 @@ func (p *Protocol) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 
 		status := http.StatusOK
 		var errMsg string
+		var result *Result
 		if res != nil {
-			var result *Result
 			switch {
 			case protocol.ResultAs(res, &result):
 				if result.StatusCode > 100 && result.StatusCode < 600 {
@@ -389,11 +473,21 @@ func (p *Protocol) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		}
 
 		if respMsg != nil {
+			if structured {
+				ctx = binding.WithForceStructured(ctx)
+			}
 			err := WriteResponseWriter(ctx, respMsg, status, rw, transformers...)
 			return respMsg.Finish(err)
 		}
 
+		// Successfully handled without a response event: no content.
+		if status == http.StatusOK && result == nil {
+			status = http.StatusNoContent
+		}
 		rw.WriteHeader(status)
+		if status == http.StatusNoContent {
+			return nil
+		}
 		if _, err := rw.Write([]byte(errMsg)); err != nil {
 			return err
 		}
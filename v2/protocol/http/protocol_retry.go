@@ -11,6 +11,7 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"strconv"
 	"time"
 
 	"go.uber.org/zap"
@@ -25,10 +26,15 @@ func (p *Protocol) do(ctx context.Context, req *http.Request) (binding.Message,
 
 	switch params.Strategy {
 	case cecontext.BackoffStrategyConstant, cecontext.BackoffStrategyLinear, cecontext.BackoffStrategyExponential:
-		return p.doWithRetry(ctx, params, req)
+		return p.doWithRetry(ctx, req, func(ctx context.Context, tries int, _ binding.Message) error {
+			return params.Backoff(ctx, tries)
+		})
 	case cecontext.BackoffStrategyNone:
 		fallthrough
 	default:
+		if p.retryMax > 0 {
+			return p.doWithRetry(ctx, req, p.retryBackoff)
+		}
 		return p.doOnce(req)
 	}
 }
@@ -49,7 +55,11 @@ func (p *Protocol) doOnce(req *http.Request) (binding.Message, protocol.Result)
 	return NewMessage(resp.Header, resp.Body), NewResult(resp.StatusCode, "%w", result)
 }
 
-func (p *Protocol) doWithRetry(ctx context.Context, params *cecontext.RetryParams, req *http.Request) (binding.Message, error) {
+// doWithRetry sends req until it's ACKed, the status code is not retriable or
+// backoff returns an error. backoff blocks until the next try, tries being the
+// number of times the request has been retried including the next one, and msg
+// the response of the last try, if any.
+func (p *Protocol) doWithRetry(ctx context.Context, req *http.Request, backoff func(ctx context.Context, tries int, msg binding.Message) error) (binding.Message, error) {
 	start := time.Now()
 	retry := 0
 	results := make([]protocol.Result, 0)
@@ -92,7 +102,7 @@ func (p *Protocol) doWithRetry(ctx context.Context, params *cecontext.RetryParam
 		}
 
 		// total tries = retry + 1
-		if err = params.Backoff(ctx, retry+1); err != nil {
+		if err = backoff(ctx, retry+1, msg); err != nil {
 			// do not try again.
 			cecontext.LoggerFrom(ctx).Debugw("backoff error, will not try again", zap.Error(err))
 			return msg, NewRetriesResult(result, retry, start, results)
@@ -108,6 +118,49 @@ func (p *Protocol) doWithRetry(ctx context.Context, params *cecontext.RetryParam
 	}
 }
 
+// retryBackoff is the backoff of the retries configured with WithRetry: the
+// delay is the one of the Retry-After header of the response, if any, else the
+// one returned by the backoff function.
+func (p *Protocol) retryBackoff(ctx context.Context, tries int, msg binding.Message) error {
+	if tries > p.retryMax {
+		return errors.New("too many retries")
+	}
+	delay := p.retryBackoffFn(tries)
+	if m, ok := msg.(*Message); ok {
+		if d, ok := retryAfter(m.Header, time.Now()); ok {
+			delay = d
+		}
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// retryAfter returns the delay of the Retry-After header, either in seconds or
+// an HTTP date.
+func retryAfter(header http.Header, now time.Time) (time.Duration, bool) {
+	v := header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
 // reset body to allow it to be read multiple times, e.g. when retrying http
 // requests
 func resetBody(req *http.Request, body []byte) {
@@ -224,3 +224,111 @@ func newEvent(t *testing.T, encoding string, body interface{}) event.Event {
 
 	return e
 }
+
+func TestSendWithRetry(t *testing.T) {
+	testCases := map[string]struct {
+		statusCodes      []int
+		retryAfter       string
+		wantACK          bool
+		wantRequestCount int
+		wantMinDuration  time.Duration
+	}{
+		"503, 503, 200": {
+			statusCodes:      []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+			wantACK:          true,
+			wantRequestCount: 3,
+		},
+		"400": {
+			statusCodes:      []int{http.StatusBadRequest},
+			wantRequestCount: 1,
+		},
+		"too many 503": {
+			statusCodes:      []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+			wantRequestCount: 4,
+		},
+		"Retry-After": {
+			statusCodes:      []int{http.StatusServiceUnavailable, http.StatusOK},
+			retryAfter:       "1",
+			wantACK:          true,
+			wantRequestCount: 2,
+			wantMinDuration:  time.Second,
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			requestCount := 0
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				sc := tc.statusCodes[requestCount]
+				requestCount++
+				if tc.retryAfter != "" && sc == http.StatusServiceUnavailable {
+					w.Header().Set("Retry-After", tc.retryAfter)
+				}
+				w.WriteHeader(sc)
+			}))
+			defer ts.Close()
+
+			var tries []int
+			p, err := New(WithTarget(ts.URL), WithRetry(3, func(n int) time.Duration {
+				tries = append(tries, n)
+				return time.Millisecond
+			}))
+			require.NoError(t, err)
+
+			start := time.Now()
+			e := event.New()
+			e.SetID("id")
+			e.SetSource("source")
+			e.SetType("type")
+			result := p.Send(context.Background(), binding.ToMessage(&e))
+			require.Equal(t, tc.wantACK, protocol.IsACK(result), result)
+			require.Equal(t, tc.wantRequestCount, requestCount)
+			require.GreaterOrEqual(t, time.Since(start), tc.wantMinDuration)
+			if tc.wantRequestCount > 1 {
+				require.Equal(t, 1, tries[0])
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	for v, want := range map[string]time.Duration{
+		"":        -1,
+		"garbage": -1,
+		"120":     2 * time.Minute,
+		now.Add(time.Minute).Format(http.TimeFormat):  time.Minute,
+		now.Add(-time.Minute).Format(http.TimeFormat): 0,
+	} {
+		header := http.Header{}
+		if v != "" {
+			header.Set("Retry-After", v)
+		}
+		got, ok := retryAfter(header, now)
+		if want < 0 {
+			require.False(t, ok, v)
+		} else {
+			require.True(t, ok, v)
+			require.Equal(t, want, got, v)
+		}
+	}
+}
+
+func TestSendWithRetry_NetworkError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	ts.Close()
+
+	tries := 0
+	p, err := New(WithTarget(ts.URL), WithRetry(2, func(int) time.Duration {
+		tries++
+		return time.Millisecond
+	}))
+	require.NoError(t, err)
+
+	e := event.New()
+	e.SetID("id")
+	e.SetSource("source")
+	e.SetType("type")
+	result := p.Send(context.Background(), binding.ToMessage(&e))
+	require.False(t, protocol.IsACK(result))
+	require.Equal(t, 2, tries)
+}
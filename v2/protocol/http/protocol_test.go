@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -21,7 +22,9 @@ import (
 	"golang.org/x/time/rate"
 
 	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/event"
 	"github.com/cloudevents/sdk-go/v2/protocol"
+	"github.com/cloudevents/sdk-go/v2/test"
 )
 
 func TestNew(t *testing.T) {
@@ -101,6 +104,268 @@ func TestSend(t *testing.T) {
 	}
 }
 
+func TestSend_DefaultSendTimeout(t *testing.T) {
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer func() {
+		close(release)
+		ts.Close()
+	}()
+
+	p, err := New(WithTarget(ts.URL), WithDefaultSendTimeout(50*time.Millisecond))
+	require.NoError(t, err)
+	e := test.FullEvent()
+
+	t.Run("no deadline", func(t *testing.T) {
+		start := time.Now()
+		err := p.Send(context.Background(), binding.ToMessage(&e))
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Less(t, time.Since(start), 5*time.Second)
+	})
+
+	t.Run("caller deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		err := p.Send(ctx, binding.ToMessage(&e))
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.GreaterOrEqual(t, time.Since(start), 300*time.Millisecond)
+	})
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestSend_HeaderCasing(t *testing.T) {
+	e := test.FullEvent()
+	for name, tc := range map[string]struct {
+		opts   []Option
+		wantID string
+	}{
+		"default": {
+			wantID: "Ce-Id",
+		},
+		"canonical": {
+			opts:   []Option{WithHeaderCasing(HeaderCasingCanonical)},
+			wantID: "Ce-Id",
+		},
+		"lowercase": {
+			opts:   []Option{WithHeaderCasing(HeaderCasingLowercase)},
+			wantID: "ce-id",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var got http.Header
+			opts := append([]Option{
+				WithTarget("http://localhost"),
+				WithRoundTripper(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					got = req.Header
+					return &http.Response{StatusCode: http.StatusAccepted, Body: http.NoBody, Request: req}, nil
+				})),
+			}, tc.opts...)
+			p, err := New(opts...)
+			require.NoError(t, err)
+
+			err = p.Send(binding.WithForceBinary(context.Background()), binding.ToMessage(&e))
+			require.True(t, protocol.IsACK(err), err)
+			require.Equal(t, []string{e.ID()}, got[tc.wantID])
+			require.Contains(t, got, "Content-Type")
+			for k := range got {
+				if len(k) > len(prefix) && strings.EqualFold(k[:len(prefix)], prefix) {
+					require.Equal(t, tc.wantID[:len(prefix)], k[:len(prefix)], k)
+				}
+			}
+		})
+	}
+}
+
+func TestSend_MethodAndURLTransform(t *testing.T) {
+	byType := WithURLTransform(func(e event.Event) string { return "/events/" + e.Type() })
+	for name, tc := range map[string]struct {
+		opts       []Option
+		wantMethod string
+		wantPath   string
+	}{
+		"default": {
+			wantMethod: http.MethodPost,
+			wantPath:   "/target",
+		},
+		"method": {
+			opts:       []Option{WithMethod(http.MethodPut)},
+			wantMethod: http.MethodPut,
+			wantPath:   "/target",
+		},
+		"url transform": {
+			opts:       []Option{byType},
+			wantMethod: http.MethodPost,
+			wantPath:   "/events/com.example.FullEvent",
+		},
+		"method and url transform": {
+			opts:       []Option{WithMethod(http.MethodPut), byType},
+			wantMethod: http.MethodPut,
+			wantPath:   "/events/com.example.FullEvent",
+		},
+		"empty url transform": {
+			opts:       []Option{WithURLTransform(func(event.Event) string { return "" })},
+			wantMethod: http.MethodPost,
+			wantPath:   "/target",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var gotMethod, gotPath string
+			var gotEvent *event.Event
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotMethod = r.Method
+				gotPath = r.URL.Path
+				var err error
+				gotEvent, err = binding.ToEvent(context.Background(), NewMessageFromHttpRequest(r))
+				require.NoError(t, err)
+				w.WriteHeader(http.StatusAccepted)
+			}))
+			defer ts.Close()
+
+			p, err := New(append([]Option{WithTarget(ts.URL + "/target")}, tc.opts...)...)
+			require.NoError(t, err)
+
+			e := test.FullEvent()
+			e.SetType("com.example.FullEvent")
+			err = p.Send(context.Background(), binding.ToMessage(&e))
+			require.True(t, protocol.IsACK(err), err)
+			require.Equal(t, tc.wantMethod, gotMethod)
+			require.Equal(t, tc.wantPath, gotPath)
+			require.Equal(t, e.ID(), gotEvent.ID())
+		})
+	}
+}
+
+func TestSend_ResponseHandler(t *testing.T) {
+	for name, tc := range map[string]struct {
+		statusCode int
+		body       string
+		wantACK    bool
+	}{
+		"200":            {statusCode: http.StatusOK, body: "ok", wantACK: true},
+		"202 empty body": {statusCode: http.StatusAccepted, wantACK: true},
+		"400":            {statusCode: http.StatusBadRequest, body: "invalid event"},
+		"500":            {statusCode: http.StatusInternalServerError, body: "boom"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Test", "value")
+				w.WriteHeader(tc.statusCode)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer ts.Close()
+
+			p, err := New(WithTarget(ts.URL))
+			require.NoError(t, err)
+			e := test.FullEvent()
+
+			var got *ResponseData
+			ctx := WithResponseHandler(context.Background(), func(r *ResponseData) { got = r })
+			err = p.Send(ctx, binding.ToMessage(&e))
+			require.Equal(t, tc.wantACK, protocol.IsACK(err), err)
+
+			var res *Result
+			require.True(t, protocol.ResultAs(err, &res))
+			require.Equal(t, tc.statusCode, res.StatusCode)
+			if !tc.wantACK {
+				require.Contains(t, err.Error(), tc.body)
+			}
+
+			require.NotNil(t, got)
+			require.Equal(t, tc.statusCode, got.StatusCode)
+			require.Equal(t, "value", got.Header.Get("X-Test"))
+			require.Equal(t, tc.body, string(got.Body))
+		})
+	}
+}
+
+func TestSend_Gzip(t *testing.T) {
+	withData := test.FullEvent()
+	withoutData := test.MinEvent()
+	for name, tc := range map[string]struct {
+		ctx          context.Context
+		event        event.Event
+		wantEncoding string
+	}{
+		"binary": {
+			ctx:          binding.WithForceBinary(context.Background()),
+			event:        withData,
+			wantEncoding: "gzip",
+		},
+		"structured": {
+			ctx:          binding.WithForceStructured(context.Background()),
+			event:        withData,
+			wantEncoding: "gzip",
+		},
+		"empty body": {
+			ctx:   binding.WithForceBinary(context.Background()),
+			event: withoutData,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			receiver, err := New(WithGzip())
+			require.NoError(t, err)
+
+			var gotEncoding string
+			sender, err := New(
+				WithTarget("http://localhost"),
+				WithGzip(),
+				WithRoundTripper(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					gotEncoding = req.Header.Get("Content-Encoding")
+					rec := httptest.NewRecorder()
+					receiver.ServeHTTP(rec, req)
+					return rec.Result(), nil
+				})),
+			)
+			require.NoError(t, err)
+
+			received := make(chan *event.Event, 1)
+			go func() {
+				m, fn, err := receiver.Respond(context.Background())
+				require.NoError(t, err)
+				e, err := binding.ToEvent(context.Background(), m)
+				require.NoError(t, err)
+				received <- e
+				require.NoError(t, fn(context.Background(), nil, protocol.ResultACK))
+			}()
+
+			err = sender.Send(tc.ctx, binding.ToMessage(&tc.event))
+			require.True(t, protocol.IsACK(err), err)
+			require.Equal(t, tc.wantEncoding, gotEncoding)
+			test.AssertEventEquals(t, test.ConvertEventExtensionsToString(t, tc.event), test.ConvertEventExtensionsToString(t, *<-received))
+		})
+	}
+}
+
+func TestServeHTTP_CorruptGzip(t *testing.T) {
+	p, err := New(WithGzip())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("POST", "http://unittest", strings.NewReader("this is not a gzip stream"))
+	req.Header.Set("Content-Type", event.ApplicationCloudEventsJSON)
+	req.Header.Set("Content-Encoding", "gzip")
+	rec := httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		p.ServeHTTP(rec, req)
+		close(done)
+	}()
+
+	_, err = p.Receive(context.Background())
+	require.EqualError(t, err, "cannot decode the gzip body: gzip: invalid header")
+	<-done
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Contains(t, rec.Body.String(), "cannot decode the gzip body")
+}
+
 func TestRequest(t *testing.T) {
 	testCases := map[string]struct {
 		ctx     context.Context
@@ -0,0 +1,44 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package http
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+)
+
+// Transcode returns the event of m encoded in the target encoding, either
+// binding.EncodingBinary or binding.EncodingStructured, e.g. for intermediaries
+// forwarding the events to systems supporting a single content mode. The
+// attributes, the extensions and the data are preserved.
+// The body of m is consumed, and finishing the returned message finishes m.
+func Transcode(m *Message, target binding.Encoding) (*Message, error) {
+	if m == nil {
+		return nil, fmt.Errorf("nil Message")
+	}
+
+	var ctx context.Context
+	switch target {
+	case binding.EncodingBinary:
+		ctx = binding.WithForceBinary(context.Background())
+	case binding.EncodingStructured:
+		ctx = binding.WithForceStructured(context.Background())
+	default:
+		return nil, fmt.Errorf("cannot transcode to the %s encoding, expected binary or structured", target)
+	}
+
+	req := &nethttp.Request{Header: make(nethttp.Header)}
+	if err := WriteRequest(ctx, m, req); err != nil {
+		return nil, err
+	}
+	out := NewMessage(req.Header, req.Body)
+	out.ctx = m.ctx
+	out.OnFinish = m.Finish
+	return out, nil
+}
@@ -0,0 +1,83 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/test"
+)
+
+func TestTranscode(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		from   context.Context
+		target binding.Encoding
+	}{
+		{
+			name:   "structured to binary",
+			from:   binding.WithForceStructured(context.Background()),
+			target: binding.EncodingBinary,
+		},
+		{
+			name:   "binary to structured",
+			from:   binding.WithForceBinary(context.Background()),
+			target: binding.EncodingStructured,
+		},
+		{
+			name:   "binary to binary",
+			from:   binding.WithForceBinary(context.Background()),
+			target: binding.EncodingBinary,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			test.EachEvent(t, test.Events(), func(t *testing.T, e event.Event) {
+				e = test.ConvertEventExtensionsToString(t, e)
+				req := httptest.NewRequest("POST", "http://localhost", nil)
+				require.NoError(t, WriteRequest(tc.from, binding.ToMessage(&e), req))
+				m := NewMessageFromHttpRequest(req)
+
+				var finished error
+				m.OnFinish = func(err error) error {
+					finished = err
+					return nil
+				}
+				got, err := Transcode(m, tc.target)
+				require.NoError(t, err)
+				require.Equal(t, tc.target, got.ReadEncoding())
+
+				gotEvent, err := binding.ToEvent(context.Background(), got)
+				require.NoError(t, err)
+				test.AssertEventEquals(t, e, test.ConvertEventExtensionsToString(t, *gotEvent))
+
+				finishErr := errors.New("finished")
+				require.NoError(t, got.Finish(finishErr))
+				require.Equal(t, finishErr, finished)
+			})
+		})
+	}
+}
+
+func TestTranscode_Errors(t *testing.T) {
+	_, err := Transcode(nil, binding.EncodingBinary)
+	require.EqualError(t, err, "nil Message")
+
+	e := test.MinEvent()
+	req := httptest.NewRequest("POST", "http://localhost", nil)
+	require.NoError(t, WriteRequest(context.Background(), binding.ToMessage(&e), req))
+	_, err = Transcode(NewMessageFromHttpRequest(req), binding.EncodingBatch)
+	require.EqualError(t, err, "cannot transcode to the batch encoding, expected binary or structured")
+
+	_, err = Transcode(NewMessageFromHttpRequest(httptest.NewRequest("POST", "http://localhost", nil)), binding.EncodingBinary)
+	require.ErrorIs(t, err, binding.ErrUnknownEncoding)
+}
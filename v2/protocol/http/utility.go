@@ -16,7 +16,14 @@ import (
 )
 
 // NewEventFromHTTPRequest returns an Event.
+// The spec version is read from the request, so CloudEvents 0.1 requests are
+// read as well, see NewEventFromV01HTTPRequest.
 func NewEventFromHTTPRequest(req *nethttp.Request) (*event.Event, error) {
+	if isV01, err := isV01Request(req); err != nil {
+		return nil, err
+	} else if isV01 {
+		return NewEventFromV01HTTPRequest(req)
+	}
 	msg := NewMessageFromHttpRequest(req)
 	return binding.ToEvent(context.Background(), msg)
 }
@@ -59,6 +66,9 @@ func NewHTTPRequestFromEvent(ctx context.Context, url string, event event.Event)
 
 // NewHTTPRequestFromEvents creates a http.Request object that can be used with any http.Client for sending
 // a batched set of events. This is an HTTP POST action to the provided url.
+// An empty batch is sent as an empty JSON array, and events of different spec versions can be mixed.
+// Events sharing the same source and id are rejected with a *BatchCollisionError,
+// see WithBatchCollisionsWarnOnly and WithBatchCollisionDetector to change this behavior.
 func NewHTTPRequestFromEvents(ctx context.Context, url string, events []event.Event) (*nethttp.Request, error) {
 	// Sending batch events is quite straightforward, as there is only JSON format, so a simple implementation.
 	for _, e := range events {
@@ -66,6 +76,13 @@ func NewHTTPRequestFromEvents(ctx context.Context, url string, events []event.Ev
 			return nil, err
 		}
 	}
+	if err := checkBatchCollisions(ctx, events); err != nil {
+		return nil, err
+	}
+	if events == nil {
+		// An empty batch is an empty array, not null
+		events = []event.Event{}
+	}
 	var buffer bytes.Buffer
 	err := json.NewEncoder(&buffer).Encode(events)
 	if err != nil {
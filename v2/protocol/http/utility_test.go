@@ -8,6 +8,8 @@ package http
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -15,6 +17,7 @@ import (
 	"testing"
 
 	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/binding/format"
 	"github.com/cloudevents/sdk-go/v2/event"
 	"github.com/cloudevents/sdk-go/v2/test"
 	"github.com/google/uuid"
@@ -55,6 +58,18 @@ func TestNewEventFromHttpRequest(t *testing.T) {
 	}
 }
 
+func TestNewEventFromHttpRequest_StructuredWithBOM(t *testing.T) {
+	body := "\xEF\xBB\xBF" + `{"data":"foo","datacontenttype":"application/json","id":"id","source":"source","specversion":"1.0","type":"type"}`
+	req := httptest.NewRequest("POST", "http://localhost", strings.NewReader(body))
+	req.Header.Set("Content-Type", event.ApplicationCloudEventsJSON)
+
+	got, err := NewEventFromHTTPRequest(req)
+	require.NoError(t, err)
+	test.AssertEvent(t, *got, test.IsValid())
+	require.Equal(t, "id", got.ID())
+	require.Equal(t, []byte(`"foo"`), got.Data())
+}
+
 func TestNewEventFromHttpResponse(t *testing.T) {
 	tests := []struct {
 		name string
@@ -210,11 +225,76 @@ func TestNewHTTPRequestFromEvent(t *testing.T) {
 		require.Equal(t, &e, result)
 	})
 
+	t.Run("structured content type", func(t *testing.T) {
+		e := event.New()
+		e.SetID(uuid.New().String())
+		e.SetSource("example/uri")
+		e.SetType("example.type")
+		require.NoError(t, e.SetData(event.ApplicationJSON, map[string]string{"hello": "world"}))
+
+		f, err := format.WithStructuredContentType("application/cloudevents+json; profile=acme")
+		require.NoError(t, err)
+		ctx := binding.UseFormatForEvent(binding.WithForceStructured(context.Background()), f)
+		req, err := NewHTTPRequestFromEvent(ctx, ts.URL, e)
+		require.NoError(t, err)
+		require.Equal(t, "application/cloudevents+json; profile=acme", req.Header.Get(ContentType))
+
+		resp, err := ts.Client().Do(req)
+		require.NoError(t, err)
+		require.Equal(t, "application/cloudevents+json; profile=acme", resp.Header.Get(ContentType))
+
+		result, err := NewEventFromHTTPResponse(resp)
+		require.NoError(t, err)
+		require.Equal(t, &e, result)
+	})
+
+	t.Run("data body", func(t *testing.T) {
+		type payload struct {
+			Hello string `json:"hello"`
+			Count int    `json:"count,omitempty"`
+		}
+		for name, tc := range map[string]struct {
+			contentType string
+			data        interface{}
+			wantBody    string
+		}{
+			"json struct": {contentType: event.ApplicationJSON, data: payload{Hello: "world", Count: 2}, wantBody: `{"hello":"world","count":2}`},
+			"text string": {contentType: event.TextPlain, data: "hello world", wantBody: "hello world"},
+			"raw bytes":   {contentType: "application/octet-stream", data: []byte{0x00, 0xff}, wantBody: "\x00\xff"},
+		} {
+			t.Run(name, func(t *testing.T) {
+				e := event.New()
+				e.SetID(uuid.New().String())
+				e.SetSource("example/uri")
+				e.SetType("example.type")
+				require.NoError(t, e.SetData(tc.contentType, tc.data))
+				require.Equal(t, tc.contentType, e.DataContentType())
+
+				req, err := NewHTTPRequestFromEvent(context.Background(), ts.URL, e)
+				require.NoError(t, err)
+				require.Equal(t, tc.contentType, req.Header.Get(ContentType))
+				body, err := io.ReadAll(req.Body)
+				require.NoError(t, err)
+				require.Equal(t, tc.wantBody, string(body))
+			})
+		}
+	})
+
 	t.Run("invalid event", func(t *testing.T) {
 		e := event.New()
 		_, err := NewHTTPRequestFromEvent(context.Background(), ts.URL, e)
 		require.ErrorContains(t, err, "id: MUST be a non-empty string")
 	})
+
+	t.Run("missing source", func(t *testing.T) {
+		e := event.New()
+		e.SetID(uuid.New().String())
+		e.SetType("example.type")
+		_, err := NewHTTPRequestFromEvent(context.Background(), ts.URL, e)
+		require.ErrorContains(t, err, "source: REQUIRED")
+		require.NotContains(t, err.Error(), "id:")
+		require.NotContains(t, err.Error(), "type:")
+	})
 }
 
 func TestNewHTTPRequestFromEvents(t *testing.T) {
@@ -256,11 +336,140 @@ func TestNewHTTPRequestFromEvents(t *testing.T) {
 		require.Equal(t, events, result)
 	})
 
+	t.Run("empty batch", func(t *testing.T) {
+		for name, events := range map[string][]event.Event{"nil": nil, "empty": {}} {
+			t.Run(name, func(t *testing.T) {
+				req, err := NewHTTPRequestFromEvents(context.Background(), ts.URL, events)
+				require.NoError(t, err)
+				require.True(t, IsHTTPBatch(req.Header))
+				body, err := io.ReadAll(req.Body)
+				require.NoError(t, err)
+				require.Equal(t, "[]", strings.TrimSpace(string(body)))
+				req.Body = io.NopCloser(bytes.NewReader(body))
+
+				result, err := NewEventsFromHTTPRequest(req)
+				require.NoError(t, err)
+				require.Empty(t, result)
+			})
+		}
+	})
+
+	t.Run("mixed spec versions", func(t *testing.T) {
+		var events []event.Event
+		for _, version := range []string{event.CloudEventsVersionV03, event.CloudEventsVersionV1} {
+			e := event.New(version)
+			e.SetID(uuid.New().String())
+			e.SetSource("example/uri")
+			e.SetType("example.type")
+			require.NoError(t, e.SetData(event.ApplicationJSON, map[string]string{"version": version}))
+			events = append(events, e)
+		}
+
+		req, err := NewHTTPRequestFromEvents(context.Background(), ts.URL, events)
+		require.NoError(t, err)
+
+		// each entry of the batch carries its own specversion
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		var entries []map[string]interface{}
+		require.NoError(t, json.Unmarshal(body, &entries))
+		require.Len(t, entries, 2)
+		require.Equal(t, event.CloudEventsVersionV03, entries[0]["specversion"])
+		require.Equal(t, event.CloudEventsVersionV1, entries[1]["specversion"])
+		req.Body = io.NopCloser(bytes.NewReader(body))
+
+		resp, err := ts.Client().Do(req)
+		require.NoError(t, err)
+
+		result, err := NewEventsFromHTTPResponse(resp)
+		require.NoError(t, err)
+		require.Equal(t, events, result)
+		require.Equal(t, event.CloudEventsVersionV03, result[0].SpecVersion())
+		require.Equal(t, event.CloudEventsVersionV1, result[1].SpecVersion())
+	})
+
+	t.Run("mixed data content types", func(t *testing.T) {
+		jsonEvent := event.New()
+		jsonEvent.SetID(uuid.New().String())
+		jsonEvent.SetSource("example/uri")
+		jsonEvent.SetType("example.json")
+		require.NoError(t, jsonEvent.SetData(event.ApplicationJSON, map[string]string{"hello": "world"}))
+
+		textEvent := event.New()
+		textEvent.SetID(uuid.New().String())
+		textEvent.SetSource("example/uri")
+		textEvent.SetType("example.text")
+		require.NoError(t, textEvent.SetData(event.TextPlain, "hello world"))
+
+		events := []event.Event{jsonEvent, textEvent}
+		req, err := NewHTTPRequestFromEvents(context.Background(), ts.URL, events)
+		require.NoError(t, err)
+
+		resp, err := ts.Client().Do(req)
+		require.NoError(t, err)
+
+		result, err := NewEventsFromHTTPResponse(resp)
+		require.NoError(t, err)
+		require.Equal(t, events, result)
+		require.Equal(t, event.ApplicationJSON, result[0].DataContentType())
+		require.Equal(t, event.TextPlain, result[1].DataContentType())
+		var text string
+		require.NoError(t, result[1].DataAs(&text))
+		require.Equal(t, "hello world", text)
+	})
+
 	t.Run("invalid events", func(t *testing.T) {
 		events := []event.Event{event.New()}
 		_, err := NewHTTPRequestFromEvents(context.Background(), ts.URL, events)
 		require.ErrorContains(t, err, "id: MUST be a non-empty string")
 	})
+
+	duplicates := func() []event.Event {
+		var events []event.Event
+		for _, source := range []string{"example/a", "example/b", "example/a", "example/a"} {
+			e := event.New()
+			e.SetID("same-id")
+			e.SetSource(source)
+			e.SetType("example.type")
+			events = append(events, e)
+		}
+		return events
+	}
+
+	t.Run("duplicate identities", func(t *testing.T) {
+		_, err := NewHTTPRequestFromEvents(context.Background(), ts.URL, duplicates())
+		var collisionErr *BatchCollisionError
+		require.ErrorAs(t, err, &collisionErr)
+		require.Equal(t, []BatchCollision{{Source: "example/a", ID: "same-id", Indexes: []int{0, 2, 3}}}, collisionErr.Collisions)
+		require.EqualError(t, err, `duplicate event identities in batch: (source="example/a", id="same-id") at [0 2 3]`)
+	})
+
+	t.Run("duplicate identities warn only", func(t *testing.T) {
+		ctx := WithBatchCollisionsWarnOnly(context.Background())
+		req, err := NewHTTPRequestFromEvents(ctx, ts.URL, duplicates())
+		require.NoError(t, err)
+
+		resp, err := ts.Client().Do(req)
+		require.NoError(t, err)
+		result, err := NewEventsFromHTTPResponse(resp)
+		require.NoError(t, err)
+		require.Len(t, result, 4)
+	})
+
+	t.Run("custom collision detector", func(t *testing.T) {
+		ctx := WithBatchCollisionDetector(context.Background(), nil)
+		_, err := NewHTTPRequestFromEvents(ctx, ts.URL, duplicates())
+		require.NoError(t, err)
+
+		ctx = WithBatchCollisionDetector(context.Background(), func(events []event.Event) error {
+			if len(events) > 3 {
+				return fmt.Errorf("too many events: %d", len(events))
+			}
+			return nil
+		})
+		_, err = NewHTTPRequestFromEvents(ctx, ts.URL, duplicates())
+		require.EqualError(t, err, "too many events: 4")
+	})
 }
 
 func TestIsHTTPBatch(t *testing.T) {
@@ -273,3 +482,34 @@ func TestIsHTTPBatch(t *testing.T) {
 	header.Set(ContentType, event.ApplicationCloudEventsBatchJSON)
 	assert.True(t, IsHTTPBatch(header))
 }
+
+func TestNewEventFromHTTPRequest_V01(t *testing.T) {
+	for name, newRequest := range map[string]func() *http.Request{
+		"binary": func() *http.Request {
+			req := httptest.NewRequest("POST", "http://localhost", strings.NewReader(`{"a":"b"}`))
+			req.Header.Set("CE-CloudEventsVersion", "0.1")
+			req.Header.Set("CE-EventType", "type")
+			req.Header.Set("CE-Source", "source")
+			req.Header.Set("CE-EventID", "id")
+			req.Header.Set(ContentType, event.ApplicationJSON)
+			return req
+		},
+		"structured": func() *http.Request {
+			req := httptest.NewRequest("POST", "http://localhost", strings.NewReader(
+				`{"cloudEventsVersion":"0.1","eventType":"type","source":"source","eventID":"id","contentType":"application/json","data":{"a":"b"}}`,
+			))
+			req.Header.Set(ContentType, event.ApplicationCloudEventsJSON)
+			return req
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewEventFromHTTPRequest(newRequest())
+			require.NoError(t, err)
+			require.Equal(t, event.CloudEventsVersionV1, got.SpecVersion())
+			require.Equal(t, "type", got.Type())
+			require.Equal(t, "source", got.Source())
+			require.Equal(t, "id", got.ID())
+			require.Equal(t, []byte(`{"a":"b"}`), got.Data())
+		})
+	}
+}
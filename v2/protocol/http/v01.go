@@ -0,0 +1,240 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	nethttp "net/http"
+	"strings"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/types"
+)
+
+// CloudEventsVersionV01 is the legacy 0.1 version of the CloudEvents spec.
+const CloudEventsVersionV01 = "0.1"
+
+// EventTypeVersionExtension is the extension holding the eventTypeVersion
+// attribute of CloudEvents 0.1, which has no equivalent in the later versions.
+const EventTypeVersionExtension = "eventtypeversion"
+
+const (
+	headerV01CloudEventsVersion = "Ce-Cloudeventsversion"
+	headerV01EventType          = "Ce-Eventtype"
+	headerV01EventTypeVersion   = "Ce-Eventtypeversion"
+	headerV01Source             = "Ce-Source"
+	headerV01EventID            = "Ce-Eventid"
+	headerV01EventTime          = "Ce-Eventtime"
+	headerV01SchemaURL          = "Ce-Schemaurl"
+	headerV01ExtensionPrefix    = "Ce-X-"
+)
+
+// eventV01 is the JSON format of CloudEvents 0.1.
+type eventV01 struct {
+	CloudEventsVersion string                 `json:"cloudEventsVersion"`
+	EventType          string                 `json:"eventType"`
+	EventTypeVersion   string                 `json:"eventTypeVersion,omitempty"`
+	Source             string                 `json:"source"`
+	EventID            string                 `json:"eventID"`
+	EventTime          string                 `json:"eventTime,omitempty"`
+	SchemaURL          string                 `json:"schemaURL,omitempty"`
+	ContentType        string                 `json:"contentType,omitempty"`
+	Extensions         map[string]interface{} `json:"extensions,omitempty"`
+	Data               json.RawMessage        `json:"data,omitempty"`
+}
+
+// NewEventFromV01HTTPRequest reads a CloudEvents 0.1 event, in binary or
+// structured JSON mode, for interoperability with legacy producers.
+// There is no 0.1 event context in this SDK, so the event is converted to the
+// default spec version: eventType, eventID, eventTime, schemaURL and contentType
+// are mapped to type, id, time, dataschema and datacontenttype, eventTypeVersion
+// to the EventTypeVersionExtension extension and the 0.1 extensions to
+// extensions with lower case names.
+func NewEventFromV01HTTPRequest(req *nethttp.Request) (*event.Event, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		_ = req.Body.Close()
+	}
+
+	if version := req.Header.Get(headerV01CloudEventsVersion); version != "" {
+		return newEventFromV01Binary(version, req.Header, body)
+	}
+	mediaType, _, err := mime.ParseMediaType(req.Header.Get(ContentType))
+	if err != nil || mediaType != event.ApplicationCloudEventsJSON {
+		return nil, fmt.Errorf("not a CloudEvents %s request: missing the %s header", CloudEventsVersionV01, headerV01CloudEventsVersion)
+	}
+	return newEventFromV01Structured(body)
+}
+
+// isV01Request returns whether req carries a CloudEvents 0.1 event. The body of
+// structured requests is read to look for the 0.1 version attribute, and
+// replaced so that it can be read again.
+func isV01Request(req *nethttp.Request) (bool, error) {
+	if req.Header.Get(headerV01CloudEventsVersion) != "" {
+		return true, nil
+	}
+	if mediaType, _, _ := mime.ParseMediaType(req.Header.Get(ContentType)); mediaType != event.ApplicationCloudEventsJSON || req.Body == nil {
+		return false, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return false, err
+	}
+	_ = req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	var version struct {
+		CloudEventsVersion string `json:"cloudEventsVersion"`
+	}
+	// Malformed bodies are reported by the reader of the spec version
+	_ = json.Unmarshal(body, &version)
+	return version.CloudEventsVersion != "", nil
+}
+
+func newEventFromV01Binary(version string, header nethttp.Header, body []byte) (*event.Event, error) {
+	in := eventV01{
+		CloudEventsVersion: version,
+		EventType:          header.Get(headerV01EventType),
+		EventTypeVersion:   header.Get(headerV01EventTypeVersion),
+		Source:             header.Get(headerV01Source),
+		EventID:            header.Get(headerV01EventID),
+		EventTime:          header.Get(headerV01EventTime),
+		SchemaURL:          header.Get(headerV01SchemaURL),
+		ContentType:        header.Get(ContentType),
+	}
+	for k, v := range header {
+		if strings.HasPrefix(k, headerV01ExtensionPrefix) && len(v) > 0 {
+			if in.Extensions == nil {
+				in.Extensions = make(map[string]interface{})
+			}
+			in.Extensions[k[len(headerV01ExtensionPrefix):]] = v[0]
+		}
+	}
+
+	e, err := in.toEvent()
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > 0 {
+		e.DataEncoded = body
+	}
+	return e, nil
+}
+
+func newEventFromV01Structured(body []byte) (*event.Event, error) {
+	var in eventV01
+	if err := json.Unmarshal(body, &in); err != nil {
+		return nil, err
+	}
+
+	e, err := in.toEvent()
+	if err != nil {
+		return nil, err
+	}
+	if len(in.Data) == 0 || bytes.Equal(in.Data, []byte("null")) {
+		return e, nil
+	}
+	if in.Data[0] == '"' && !isV01JSONContentType(in.ContentType) {
+		// Data which is not JSON is a JSON string
+		var s string
+		if err := json.Unmarshal(in.Data, &s); err != nil {
+			return nil, err
+		}
+		e.DataEncoded = []byte(s)
+	} else {
+		e.DataEncoded = in.Data
+	}
+	return e, nil
+}
+
+func (in eventV01) toEvent() (*event.Event, error) {
+	if in.CloudEventsVersion != CloudEventsVersionV01 {
+		return nil, fmt.Errorf("unsupported CloudEvents version %q, expected %s", in.CloudEventsVersion, CloudEventsVersionV01)
+	}
+
+	e := event.New()
+	e.SetType(in.EventType)
+	e.SetSource(in.Source)
+	e.SetID(in.EventID)
+	if in.EventTime != "" {
+		t, err := types.ParseTime(in.EventTime)
+		if err != nil {
+			return nil, fmt.Errorf("eventTime: %w", err)
+		}
+		e.SetTime(t)
+	}
+	if in.SchemaURL != "" {
+		e.SetDataSchema(in.SchemaURL)
+	}
+	if in.ContentType != "" {
+		e.SetDataContentType(in.ContentType)
+	}
+	if in.EventTypeVersion != "" {
+		e.SetExtension(EventTypeVersionExtension, in.EventTypeVersion)
+	}
+	for k, v := range in.Extensions {
+		e.SetExtension(strings.ToLower(k), v)
+	}
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// WriteV01Request writes e to req as a CloudEvents 0.1 event in binary mode,
+// for interoperability with legacy consumers. The attributes are mapped the
+// same way as NewEventFromV01HTTPRequest does, the other extensions are written
+// as CE-X-* headers.
+func WriteV01Request(e event.Event, req *nethttp.Request) error {
+	if err := e.Validate(); err != nil {
+		return err
+	}
+	if req.Header == nil {
+		req.Header = make(nethttp.Header)
+	}
+
+	req.Header.Set(headerV01CloudEventsVersion, CloudEventsVersionV01)
+	req.Header.Set(headerV01EventType, e.Type())
+	req.Header.Set(headerV01Source, e.Source())
+	req.Header.Set(headerV01EventID, e.ID())
+	if !e.Time().IsZero() {
+		req.Header.Set(headerV01EventTime, types.FormatTime(e.Time()))
+	}
+	if schema := e.DataSchema(); schema != "" {
+		req.Header.Set(headerV01SchemaURL, schema)
+	}
+	if ct := e.DataContentType(); ct != "" {
+		req.Header.Set(ContentType, ct)
+	}
+
+	for name, value := range e.Extensions() {
+		s, err := types.Format(value)
+		if err != nil {
+			return err
+		}
+		if name == EventTypeVersionExtension {
+			req.Header.Set(headerV01EventTypeVersion, s)
+		} else {
+			req.Header.Set(headerV01ExtensionPrefix+name, s)
+		}
+	}
+
+	return (*httpRequestWriter)(req).setBody(bytes.NewReader(e.Data()))
+}
+
+func isV01JSONContentType(contentType string) bool {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	return mediaType == "" || mediaType == event.ApplicationJSON || mediaType == event.TextJSON || strings.HasSuffix(mediaType, "+json")
+}
@@ -0,0 +1,138 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package http
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+)
+
+func TestNewEventFromV01HTTPRequest(t *testing.T) {
+	eventTime := time.Date(2018, 4, 5, 17, 31, 0, 0, time.UTC)
+	want := func(data []byte) *event.Event {
+		e := event.New()
+		e.SetType("com.example.someevent")
+		e.SetSource("/mycontext")
+		e.SetID("A234-1234-1234")
+		e.SetTime(eventTime)
+		e.SetDataSchema("http://example.com/schema")
+		e.SetDataContentType(event.ApplicationJSON)
+		e.SetExtension(EventTypeVersionExtension, "1.0")
+		e.SetExtension("comexampleextension", "value")
+		e.DataEncoded = data
+		return &e
+	}
+
+	t.Run("binary", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "http://localhost", strings.NewReader(`{"a":"b"}`))
+		req.Header.Set("CE-CloudEventsVersion", "0.1")
+		req.Header.Set("CE-EventType", "com.example.someevent")
+		req.Header.Set("CE-EventTypeVersion", "1.0")
+		req.Header.Set("CE-Source", "/mycontext")
+		req.Header.Set("CE-EventID", "A234-1234-1234")
+		req.Header.Set("CE-EventTime", "2018-04-05T17:31:00Z")
+		req.Header.Set("CE-SchemaURL", "http://example.com/schema")
+		req.Header.Set("CE-X-ComExampleExtension", "value")
+		req.Header.Set(ContentType, event.ApplicationJSON)
+
+		got, err := NewEventFromV01HTTPRequest(req)
+		require.NoError(t, err)
+		require.Equal(t, want([]byte(`{"a":"b"}`)), got)
+	})
+
+	t.Run("structured", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "http://localhost", strings.NewReader(`{
+			"cloudEventsVersion": "0.1",
+			"eventType": "com.example.someevent",
+			"eventTypeVersion": "1.0",
+			"source": "/mycontext",
+			"eventID": "A234-1234-1234",
+			"eventTime": "2018-04-05T17:31:00Z",
+			"schemaURL": "http://example.com/schema",
+			"contentType": "application/json",
+			"extensions": {"comExampleExtension": "value"},
+			"data": {"a":"b"}
+		}`))
+		req.Header.Set(ContentType, event.ApplicationCloudEventsJSON+"; charset=utf-8")
+
+		got, err := NewEventFromV01HTTPRequest(req)
+		require.NoError(t, err)
+		require.Equal(t, want([]byte(`{"a":"b"}`)), got)
+	})
+
+	t.Run("structured string data", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "http://localhost", strings.NewReader(
+			`{"cloudEventsVersion":"0.1","eventType":"type","source":"source","eventID":"id","contentType":"text/plain","data":"hello"}`,
+		))
+		req.Header.Set(ContentType, event.ApplicationCloudEventsJSON)
+
+		got, err := NewEventFromV01HTTPRequest(req)
+		require.NoError(t, err)
+		require.Equal(t, []byte("hello"), got.Data())
+	})
+
+	t.Run("not 0.1", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "http://localhost", strings.NewReader(
+			`{"specversion":"1.0","type":"type","source":"source","id":"id"}`,
+		))
+		req.Header.Set(ContentType, event.ApplicationCloudEventsJSON)
+
+		_, err := NewEventFromV01HTTPRequest(req)
+		require.Error(t, err)
+
+		req = httptest.NewRequest("POST", "http://localhost", nil)
+		req.Header.Set("Ce-Specversion", "1.0")
+		_, err = NewEventFromV01HTTPRequest(req)
+		require.Error(t, err)
+	})
+
+	t.Run("missing eventID", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "http://localhost", nil)
+		req.Header.Set("CE-CloudEventsVersion", "0.1")
+		req.Header.Set("CE-EventType", "type")
+		req.Header.Set("CE-Source", "source")
+
+		_, err := NewEventFromV01HTTPRequest(req)
+		require.Error(t, err)
+	})
+}
+
+func TestWriteV01Request(t *testing.T) {
+	e := event.New()
+	e.SetType("com.example.someevent")
+	e.SetSource("/mycontext")
+	e.SetID("A234-1234-1234")
+	e.SetTime(time.Date(2018, 4, 5, 17, 31, 0, 0, time.UTC))
+	e.SetExtension(EventTypeVersionExtension, "1.0")
+	e.SetExtension("comexampleextension", "value")
+	require.NoError(t, e.SetData(event.ApplicationJSON, []byte(`{"a":"b"}`)))
+
+	req := httptest.NewRequest("POST", "http://localhost", nil)
+	require.NoError(t, WriteV01Request(e, req))
+	require.Equal(t, "0.1", req.Header.Get("CE-CloudEventsVersion"))
+	require.Equal(t, "com.example.someevent", req.Header.Get("CE-EventType"))
+	require.Equal(t, "1.0", req.Header.Get("CE-EventTypeVersion"))
+	require.Equal(t, "/mycontext", req.Header.Get("CE-Source"))
+	require.Equal(t, "A234-1234-1234", req.Header.Get("CE-EventID"))
+	require.Equal(t, "2018-04-05T17:31:00Z", req.Header.Get("CE-EventTime"))
+	require.Equal(t, "value", req.Header.Get("CE-X-ComExampleExtension"))
+	require.Equal(t, event.ApplicationJSON, req.Header.Get(ContentType))
+	require.Empty(t, req.Header.Get("Ce-Specversion"))
+	require.Equal(t, int64(len(`{"a":"b"}`)), req.ContentLength)
+
+	got, err := NewEventFromV01HTTPRequest(req)
+	require.NoError(t, err)
+	require.Equal(t, e.Context, got.Context)
+	require.Equal(t, e.Data(), got.Data())
+
+	require.Error(t, WriteV01Request(event.New(), httptest.NewRequest("POST", "http://localhost", nil)))
+}
@@ -0,0 +1,10 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+/*
+Package replay implements a sender replaying a captured sequence of events,
+reproducing their original inter-arrival timing.
+*/
+package replay
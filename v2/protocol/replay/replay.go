@@ -0,0 +1,133 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package replay
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+)
+
+// Replayer sends events reproducing the cadence given by their time attribute.
+type Replayer struct {
+	sender protocol.Sender
+	speed  float64
+
+	// now and after are replaced in tests
+	now   func() time.Time
+	after func(time.Duration) <-chan time.Time
+}
+
+// New creates a Replayer sending the events with sender.
+// speed scales the original cadence: 1 reproduces it, 2 replays the events
+// twice as fast, 0.5 twice as slow.
+func New(sender protocol.Sender, speed float64) (*Replayer, error) {
+	if sender == nil {
+		return nil, fmt.Errorf("replay sender can not be nil")
+	}
+	if speed <= 0 {
+		return nil, fmt.Errorf("invalid replay speed %v, expected a positive number", speed)
+	}
+	return &Replayer{
+		sender: sender,
+		speed:  speed,
+		now:    time.Now,
+		after:  time.After,
+	}, nil
+}
+
+// Replay sends the events, the first one immediately and the following ones
+// after the time elapsed between their time attribute and the first event
+// time, divided by the speed.
+// Events are sent in the order of their time attribute, so out of order events
+// are sent with their original cadence too; events without time are sent right
+// after the event preceding them in events.
+// Each event is sent at its scheduled time regardless of the in-flight sends, so
+// that slow sends don't delay the following events. Replay waits for all the sends
+// to complete, and returns the first error in the replay order, if any.
+// If ctx is done, the events not sent yet are skipped.
+func (r *Replayer) Replay(ctx context.Context, events []event.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	schedule := scheduleOf(events)
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(schedule))
+	start := r.now()
+	base := schedule[0].time
+
+	func() {
+		for i, s := range schedule {
+			delay := time.Duration(float64(s.time.Sub(base)) / r.speed)
+			if wait := delay - r.now().Sub(start); wait > 0 {
+				select {
+				case <-ctx.Done():
+					errs[i] = ctx.Err()
+					return
+				case <-r.after(wait):
+				}
+			}
+			if err := ctx.Err(); err != nil {
+				errs[i] = err
+				return
+			}
+
+			wg.Add(1)
+			go func(i int, e event.Event) {
+				defer wg.Done()
+				if result := r.sender.Send(ctx, binding.ToMessage(&e)); !protocol.IsACK(result) {
+					errs[i] = fmt.Errorf("failed to replay event %q: %w", e.ID(), result)
+				}
+			}(i, events[s.index])
+		}
+	}()
+
+	// Drain the in-flight sends
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type scheduled struct {
+	index int
+	time  time.Time
+}
+
+// scheduleOf returns the events indexes sorted by time. Events without time
+// take the time of the event preceding them, or of the first event with time
+// if none precedes them.
+func scheduleOf(events []event.Event) []scheduled {
+	schedule := make([]scheduled, len(events))
+	var last time.Time
+	firstTimed := -1
+	for i, e := range events {
+		if e.Context != nil && !e.Time().IsZero() {
+			last = e.Time()
+			if firstTimed < 0 {
+				firstTimed = i
+			}
+		}
+		schedule[i] = scheduled{index: i, time: last}
+	}
+	for i := 0; i < firstTimed; i++ {
+		schedule[i].time = schedule[firstTimed].time
+	}
+	sort.SliceStable(schedule, func(i, j int) bool {
+		return schedule[i].time.Before(schedule[j].time)
+	})
+	return schedule
+}
@@ -0,0 +1,135 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package replay
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/event"
+)
+
+type recordingSender struct {
+	mu   sync.Mutex
+	ids  []string
+	fail string
+}
+
+func (s *recordingSender) Send(ctx context.Context, m binding.Message, transformers ...binding.Transformer) error {
+	e, err := binding.ToEvent(ctx, m, transformers...)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ids = append(s.ids, e.ID())
+	if e.ID() == s.fail {
+		return errors.New("send failed")
+	}
+	return nil
+}
+
+func newTestEvent(id string, t time.Time) event.Event {
+	e := event.New()
+	e.SetID(id)
+	e.SetType("unit.test")
+	e.SetSource("/unit/test")
+	if !t.IsZero() {
+		e.SetTime(t)
+	}
+	return e
+}
+
+// newFakeClockReplayer returns a Replayer whose clock only advances when
+// waiting, recording the waits.
+func newFakeClockReplayer(t *testing.T, sender *recordingSender, speed float64) (*Replayer, *[]time.Duration) {
+	r, err := New(sender, speed)
+	require.NoError(t, err)
+
+	now := time.Now()
+	var waits []time.Duration
+	r.now = func() time.Time { return now }
+	r.after = func(d time.Duration) <-chan time.Time {
+		waits = append(waits, d)
+		now = now.Add(d)
+		ch := make(chan time.Time, 1)
+		ch <- now
+		return ch
+	}
+	return r, &waits
+}
+
+func TestReplay(t *testing.T) {
+	base := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	events := []event.Event{
+		newTestEvent("untimed-first", time.Time{}),
+		newTestEvent("a", base),
+		newTestEvent("c", base.Add(3*time.Second)),
+		newTestEvent("untimed", time.Time{}),
+		newTestEvent("b", base.Add(time.Second)),
+	}
+
+	for name, tc := range map[string]struct {
+		speed float64
+		want  []time.Duration
+	}{
+		"original cadence": {speed: 1, want: []time.Duration{time.Second, 2 * time.Second}},
+		"twice as fast":    {speed: 2, want: []time.Duration{500 * time.Millisecond, time.Second}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			sender := &recordingSender{}
+			r, waits := newFakeClockReplayer(t, sender, tc.speed)
+
+			require.NoError(t, r.Replay(context.Background(), events))
+			require.Equal(t, tc.want, *waits)
+			require.ElementsMatch(t, []string{"untimed-first", "a", "b", "c", "untimed"}, sender.ids)
+		})
+	}
+}
+
+func TestReplay_SendError(t *testing.T) {
+	base := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	sender := &recordingSender{fail: "b"}
+	r, _ := newFakeClockReplayer(t, sender, 1)
+
+	err := r.Replay(context.Background(), []event.Event{
+		newTestEvent("a", base),
+		newTestEvent("b", base.Add(time.Second)),
+		newTestEvent("c", base.Add(2*time.Second)),
+	})
+	require.ErrorContains(t, err, `failed to replay event "b"`)
+	// The other events are sent anyway
+	require.ElementsMatch(t, []string{"a", "b", "c"}, sender.ids)
+}
+
+func TestReplay_ContextDone(t *testing.T) {
+	base := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	sender := &recordingSender{}
+	r, err := New(sender, 1)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err = r.Replay(ctx, []event.Event{
+		newTestEvent("a", base),
+		newTestEvent("b", base.Add(time.Hour)),
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, []string{"a"}, sender.ids)
+}
+
+func TestNew_Invalid(t *testing.T) {
+	_, err := New(nil, 1)
+	require.Error(t, err)
+	_, err = New(&recordingSender{}, 0)
+	require.Error(t, err)
+}
@@ -0,0 +1,15 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+/*
+Package syslog implements a sender writing the events as RFC 5424 syslog messages.
+
+The event source is the APP-NAME and the event type is the MSGID of the message.
+The attributes and extensions are carried in a structured data element, and
+the event data is the MSG, e.g.:
+
+	<14>1 2026-01-02T03:04:05Z host /my/source - com.example.created [cloudevents@32473 id="123" source="/my/source" specversion="1.0" type="com.example.created" datacontenttype="application/json"] {"hello":"world"}
+*/
+package syslog
@@ -0,0 +1,67 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package syslog
+
+import (
+	"fmt"
+)
+
+// Option is the function signature required to be considered a syslog.Option.
+type Option func(*Sender) error
+
+// WithFacility sets the facility of the messages, from 0 (kernel) to 23 (local7).
+// Default is 1 (user-level messages).
+func WithFacility(facility int) Option {
+	return func(s *Sender) error {
+		if facility < 0 || facility > 23 {
+			return fmt.Errorf("invalid syslog facility %d", facility)
+		}
+		s.facility = facility
+		return nil
+	}
+}
+
+// WithSeverity sets the severity of the messages, from 0 (emergency) to 7 (debug).
+// Default is 6 (informational).
+func WithSeverity(severity int) Option {
+	return func(s *Sender) error {
+		if severity < 0 || severity > 7 {
+			return fmt.Errorf("invalid syslog severity %d", severity)
+		}
+		s.severity = severity
+		return nil
+	}
+}
+
+// WithHostname sets the HOSTNAME of the messages. Default is os.Hostname.
+func WithHostname(hostname string) Option {
+	return func(s *Sender) error {
+		s.hostname = hostname
+		return nil
+	}
+}
+
+// WithStructuredDataID sets the SD-ID of the structured data element carrying
+// the event attributes. Default is DefaultStructuredDataID.
+func WithStructuredDataID(id string) Option {
+	return func(s *Sender) error {
+		if id == "" || sdName(id) != id {
+			return fmt.Errorf("invalid syslog structured data id %q", id)
+		}
+		s.sdID = id
+		return nil
+	}
+}
+
+// WithOctetCounting frames each message with its length, as required by the
+// syslog transports over TCP and TLS (RFC 6587 and RFC 5425). Without it, each
+// message is written with a single Write, as required over UDP (RFC 5426).
+func WithOctetCounting() Option {
+	return func(s *Sender) error {
+		s.octetCounting = true
+		return nil
+	}
+}
@@ -0,0 +1,227 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package syslog
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/binding/spec"
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+	"github.com/cloudevents/sdk-go/v2/types"
+)
+
+// DefaultStructuredDataID is the default SD-ID of the structured data element
+// carrying the event attributes. 32473 is the private enterprise number
+// reserved for documentation (RFC 5612): set an id of your organization with
+// WithStructuredDataID to comply with RFC 5424.
+const DefaultStructuredDataID = "cloudevents@32473"
+
+const (
+	nilValue = "-"
+	// RFC 5424 allows up to microseconds
+	timestampLayout = "2006-01-02T15:04:05.999999Z07:00"
+)
+
+// Sender writes each sent event as an RFC 5424 syslog message.
+type Sender struct {
+	w io.Writer
+
+	facility      int
+	severity      int
+	hostname      string
+	sdID          string
+	octetCounting bool
+
+	// now is replaced in tests
+	now func() time.Time
+
+	mu sync.Mutex
+}
+
+// NewSender creates a Sender writing the messages to w, e.g. a net.Conn to a
+// syslog endpoint.
+func NewSender(w io.Writer, opts ...Option) (*Sender, error) {
+	s := &Sender{
+		w:        w,
+		facility: 1,
+		severity: 6,
+		sdID:     DefaultStructuredDataID,
+		now:      time.Now,
+	}
+	if hostname, err := os.Hostname(); err == nil {
+		s.hostname = hostname
+	}
+	for _, opt := range opts {
+		if err := opt(s); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Dial connects to the syslog endpoint at address over network, e.g. "udp" or
+// "tcp", and creates a Sender writing to it. Octet counting framing is enabled
+// for the stream oriented networks.
+func Dial(network, address string, opts ...Option) (*Sender, error) {
+	conn, err := net.Dial(network, address)
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(network, "tcp") || (strings.HasPrefix(network, "unix") && network != "unixgram") {
+		opts = append([]Option{WithOctetCounting()}, opts...)
+	}
+	s, err := NewSender(conn, opts...)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Sender) Send(ctx context.Context, m binding.Message, transformers ...binding.Transformer) (err error) {
+	if m == nil {
+		return fmt.Errorf("nil Message")
+	}
+	defer func() { _ = m.Finish(err) }()
+
+	e, err := binding.ToEvent(ctx, m, transformers...)
+	if err != nil {
+		return err
+	}
+	msg, err := s.format(e)
+	if err != nil {
+		return err
+	}
+	if s.octetCounting {
+		msg = append([]byte(strconv.Itoa(len(msg))+" "), msg...)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(msg)
+	return err
+}
+
+// Close closes the underlying writer, if it is an io.Closer.
+func (s *Sender) Close(ctx context.Context) error {
+	if c, ok := s.w.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// format formats e as an RFC 5424 message:
+// <PRI>1 TIMESTAMP HOSTNAME APP-NAME PROCID MSGID STRUCTURED-DATA MSG
+func (s *Sender) format(e *event.Event) ([]byte, error) {
+	var b bytes.Buffer
+	timestamp := e.Time()
+	if timestamp.IsZero() {
+		timestamp = s.now()
+	}
+	fmt.Fprintf(&b, "<%d>1 %s %s %s %s %s ",
+		s.facility*8+s.severity,
+		timestamp.UTC().Format(timestampLayout),
+		header(s.hostname, 255),
+		header(e.Source(), 48),
+		nilValue,
+		header(e.Type(), 32),
+	)
+
+	b.WriteString("[" + s.sdID)
+	for _, param := range params(e) {
+		v, err := types.Format(param.value)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&b, ` %s="%s"`, sdName(param.name), escape(v))
+	}
+	b.WriteString("]")
+
+	if data := e.Data(); len(data) > 0 {
+		b.WriteByte(' ')
+		b.Write(data)
+	}
+	return b.Bytes(), nil
+}
+
+type param struct {
+	name  string
+	value interface{}
+}
+
+// params returns the attributes, in the spec order, followed by the extensions, sorted by name.
+func params(e *event.Event) []param {
+	var out []param
+	if version := spec.VS.Version(e.SpecVersion()); version != nil {
+		for _, attr := range version.Attributes() {
+			if v := attr.Get(e.Context); v != nil {
+				out = append(out, param{name: attr.Name(), value: v})
+			}
+		}
+	}
+	exts := e.Extensions()
+	names := make([]string, 0, len(exts))
+	for name := range exts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		out = append(out, param{name: name, value: exts[name]})
+	}
+	return out
+}
+
+// header returns s as a header field of at most max printable US-ASCII
+// characters, or the NILVALUE if empty.
+func header(s string, max int) string {
+	if s = filter(s, max, isPrintable); s == "" {
+		return nilValue
+	}
+	return s
+}
+
+// sdName returns s as an SD-NAME, i.e. at most 32 printable US-ASCII characters except = ] and ".
+func sdName(s string) string {
+	return filter(s, 32, func(c byte) bool {
+		return isPrintable(c) && c != '=' && c != ']' && c != '"'
+	})
+}
+
+func isPrintable(c byte) bool {
+	return c > ' ' && c < 127
+}
+
+// filter removes the characters of s not allowed by keep and truncates the result to max characters.
+func filter(s string, max int, keep func(byte) bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s) && b.Len() < max; i++ {
+		if c := s[i]; keep(c) {
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+var paramValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+
+// escape escapes the characters of a PARAM-VALUE which must be escaped.
+func escape(s string) string {
+	return paramValueEscaper.Replace(s)
+}
+
+var _ protocol.SendCloser = (*Sender)(nil)
@@ -0,0 +1,67 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package syslog
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/event"
+)
+
+func newEvent(t *testing.T) event.Event {
+	e := event.New()
+	e.SetID("123")
+	e.SetSource("/my/source")
+	e.SetType("com.example.created")
+	e.SetTime(time.Date(2026, 1, 2, 3, 4, 5, 123456789, time.UTC))
+	e.SetExtension("note", `say "hi" \o/ [ok]`)
+	e.SetExtension("count", 42)
+	require.NoError(t, e.SetData(event.ApplicationJSON, map[string]string{"hello": "world"}))
+	return e
+}
+
+func TestSender(t *testing.T) {
+	var buf bytes.Buffer
+	s, err := NewSender(&buf, WithHostname("host"), WithFacility(16), WithSeverity(5))
+	require.NoError(t, err)
+
+	e := newEvent(t)
+	require.NoError(t, s.Send(context.Background(), binding.ToMessage(&e)))
+	require.Equal(t, `<133>1 2026-01-02T03:04:05.123456Z host /my/source - com.example.created `+
+		`[cloudevents@32473 id="123" source="/my/source" specversion="1.0" type="com.example.created" datacontenttype="application/json" time="2026-01-02T03:04:05.123456789Z" count="42" note="say \"hi\" \\o/ [ok\]"] `+
+		`{"hello":"world"}`, buf.String())
+}
+
+func TestSender_OctetCounting(t *testing.T) {
+	var buf bytes.Buffer
+	s, err := NewSender(&buf, WithHostname("host"), WithOctetCounting(), WithStructuredDataID("ce@12345"))
+	require.NoError(t, err)
+	s.now = func() time.Time { return time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC) }
+
+	e := event.New()
+	e.SetID("123")
+	e.SetSource("urn:example:a-source-which-is-too-long-to-fit-in-the-app-name-field")
+	e.SetType("com.example.created")
+	require.NoError(t, s.Send(context.Background(), binding.ToMessage(&e)))
+
+	msg := `<14>1 2026-01-02T03:04:05Z host urn:example:a-source-which-is-too-long-to-fit-in - com.example.created ` +
+		`[ce@12345 id="123" source="urn:example:a-source-which-is-too-long-to-fit-in-the-app-name-field" specversion="1.0" type="com.example.created"]`
+	require.Equal(t, strconv.Itoa(len(msg))+" "+msg, buf.String())
+}
+
+func TestNewSender_InvalidOptions(t *testing.T) {
+	for _, opt := range []Option{WithFacility(24), WithSeverity(-1), WithStructuredDataID("bad id")} {
+		_, err := NewSender(&bytes.Buffer{}, opt)
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,16 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+/*
+Package tail implements a receiver following a file of newline delimited
+structured events, e.g. the log of an application writing a JSON CloudEvent per
+line, like the writer package does.
+
+The receiver follows the rotation and the truncation of the file and, when
+configured with an OffsetStore, resumes on restart from the first event which
+was not finished without error: the events following it are received again,
+even if they were finished.
+*/
+package tail
@@ -0,0 +1,49 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package tail
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// OffsetStore persists the offset of the file up to which the events were finished.
+type OffsetStore interface {
+	// Load returns the persisted offset, or 0 if no offset was persisted yet.
+	Load() (int64, error)
+	// Save persists the offset.
+	Save(offset int64) error
+}
+
+type fileOffsetStore string
+
+// NewFileOffsetStore returns an OffsetStore persisting the offset in the file at path.
+func NewFileOffsetStore(path string) OffsetStore {
+	return fileOffsetStore(path)
+}
+
+func (s fileOffsetStore) Load() (int64, error) {
+	b, err := os.ReadFile(string(s))
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(string(b)), 10, 64)
+}
+
+func (s fileOffsetStore) Save(offset int64) error {
+	// Write then rename, so a crash never leaves a partially written offset
+	tmp := string(s) + ".tmp"
+	if err := os.WriteFile(tmp, []byte(strconv.FormatInt(offset, 10)), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Clean(string(s)))
+}
@@ -0,0 +1,38 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package tail
+
+import (
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/binding/format"
+)
+
+// Option is the function signature required to be considered an tail.Option.
+type Option func(*Receiver)
+
+// WithOffsetStore sets the store of the offset the receiver resumes from on restart.
+// The offset is saved when the messages are finished without error.
+func WithOffsetStore(store OffsetStore) Option {
+	return func(r *Receiver) {
+		r.offsets = store
+	}
+}
+
+// WithPollInterval sets how often the file is checked for new lines, rotation and truncation.
+// Defaults to DefaultPollInterval.
+func WithPollInterval(interval time.Duration) Option {
+	return func(r *Receiver) {
+		r.pollInterval = interval
+	}
+}
+
+// WithFormat sets the format of the lines of the file. Defaults to format.JSON.
+func WithFormat(f format.Format) Option {
+	return func(r *Receiver) {
+		r.format = f
+	}
+}
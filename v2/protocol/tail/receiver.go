@@ -0,0 +1,309 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package tail
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/binding/format"
+	"github.com/cloudevents/sdk-go/v2/binding/utils"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+)
+
+// DefaultPollInterval is the default interval between two checks of the file.
+const DefaultPollInterval = time.Second
+
+// Receiver follows a file, like tail -F, and returns every line appended to it
+// as a structured message. Empty lines are skipped.
+//
+// When the end of the file is reached, the receiver waits for new lines and checks
+// if the file was rotated or truncated. After a rotation, the receiver reads
+// the remaining lines of the old file, then continues from the start of the new one.
+// After a truncation, it continues from the start of the file.
+type Receiver struct {
+	path         string
+	offsets      OffsetStore
+	pollInterval time.Duration
+	format       format.Format
+
+	// mu guards the state of the file being read
+	mu      sync.Mutex
+	started bool
+	file    *os.File
+	reader  *bufio.Reader
+	offset  int64
+	partial []byte
+	rotated bool
+
+	// commitMu guards the lines being handled, in reading order, and the
+	// offset up to which they are all finished
+	commitMu   sync.Mutex
+	generation int
+	committed  int64
+	pending    []*pendingLine
+
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+// NewReceiver creates a Receiver following the file at path.
+// The file doesn't need to exist yet.
+func NewReceiver(path string, opts ...Option) *Receiver {
+	r := &Receiver{
+		path:         path,
+		pollInterval: DefaultPollInterval,
+		format:       format.JSON,
+		done:         make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Receive returns the next line of the file, blocking until one is appended.
+// It returns io.EOF when ctx is done or the receiver is closed.
+func (r *Receiver) Receive(ctx context.Context) (binding.Message, error) {
+	if ctx == nil {
+		return nil, fmt.Errorf("nil Context")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for {
+		select {
+		case <-r.done:
+			return nil, io.EOF
+		default:
+		}
+
+		if r.file == nil {
+			if err := r.start(); err != nil {
+				return nil, err
+			}
+		}
+		if r.file != nil {
+			line, err := r.reader.ReadBytes('\n')
+			r.partial = append(r.partial, line...)
+			if err == nil {
+				line, r.partial = r.partial, nil
+				r.offset += int64(len(line))
+				if len(bytes.TrimSpace(line)) == 0 {
+					continue
+				}
+				return r.message(line), nil
+			}
+			if err != io.EOF {
+				return nil, err
+			}
+			changed, err := r.follow()
+			if err != nil {
+				return nil, err
+			}
+			if changed {
+				continue
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, io.EOF
+		case <-r.done:
+			return nil, io.EOF
+		case <-time.After(r.pollInterval):
+		}
+	}
+}
+
+// Close closes the file. Pending and later calls to Receive return io.EOF.
+func (r *Receiver) Close(ctx context.Context) error {
+	r.closeOnce.Do(func() { close(r.done) })
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+// start opens the file for the first time, from the persisted offset.
+// If the file doesn't exist yet, it's not an error: the receiver waits for it.
+func (r *Receiver) start() error {
+	offset := int64(0)
+	if !r.started && r.offsets != nil {
+		var err error
+		if offset, err = r.offsets.Load(); err != nil {
+			return fmt.Errorf("cannot load the offset: %w", err)
+		}
+	}
+	err := r.open(offset)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	r.started = true
+	return nil
+}
+
+func (r *Receiver) open(offset int64) error {
+	f, err := os.Open(r.path)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	if offset > info.Size() {
+		// The file was truncated or rotated while the receiver was stopped
+		offset = 0
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		_ = f.Close()
+		return err
+	}
+	r.file = f
+	r.reader = bufio.NewReader(f)
+	r.offset = offset
+	r.partial = nil
+	r.rotated = false
+	return r.restart(offset)
+}
+
+// follow checks if the file was rotated or truncated, once its end is reached.
+// It returns true if there may be new lines to read right away.
+func (r *Receiver) follow() (bool, error) {
+	info, err := os.Stat(r.path)
+	if errors.Is(err, os.ErrNotExist) {
+		// Rotated, but the new file is not created yet
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	current, err := r.file.Stat()
+	if err != nil {
+		return false, err
+	}
+
+	if !os.SameFile(info, current) {
+		if !r.rotated {
+			// Lines may have been appended to the old file before it was rotated,
+			// read it until its end once more before switching to the new one.
+			r.rotated = true
+			return true, nil
+		}
+		_ = r.file.Close()
+		r.file = nil
+		if err := r.open(0); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return false, err
+		}
+		return r.file != nil, nil
+	}
+
+	if info.Size() < r.offset+int64(len(r.partial)) {
+		// Truncated
+		if _, err := r.file.Seek(0, io.SeekStart); err != nil {
+			return false, err
+		}
+		r.reader.Reset(r.file)
+		r.offset = 0
+		r.partial = nil
+		return true, r.restart(0)
+	}
+	return false, nil
+}
+
+// restart resets the finished offset when the receiver starts reading a file,
+// so that the messages of the previous file don't move it anymore.
+func (r *Receiver) restart(offset int64) error {
+	r.commitMu.Lock()
+	defer r.commitMu.Unlock()
+	r.generation++
+	r.committed = offset
+	r.pending = nil
+	if r.offsets == nil {
+		return nil
+	}
+	return r.offsets.Save(offset)
+}
+
+// commit marks the line as finished, then saves the offset following the
+// lines finished so far without a gap: the lines still being handled, or
+// finished with an error, are read again on restart.
+func (r *Receiver) commit(generation int, line *pendingLine) error {
+	r.commitMu.Lock()
+	defer r.commitMu.Unlock()
+	if generation != r.generation {
+		return nil
+	}
+	line.finished = true
+	i := 0
+	for ; i < len(r.pending) && r.pending[i].finished; i++ {
+		r.committed = r.pending[i].end
+	}
+	if i == 0 {
+		return nil
+	}
+	r.pending = r.pending[i:]
+	if r.offsets == nil {
+		return nil
+	}
+	return r.offsets.Save(r.committed)
+}
+
+func (r *Receiver) message(data []byte) binding.Message {
+	r.commitMu.Lock()
+	generation := r.generation
+	line := &pendingLine{end: r.offset}
+	r.pending = append(r.pending, line)
+	r.commitMu.Unlock()
+
+	return &message{
+		Message: utils.NewStructuredMessage(r.format, bytes.NewReader(data)),
+		commit:  func() error { return r.commit(generation, line) },
+	}
+}
+
+// pendingLine is a line returned by Receive, ending at the end offset.
+type pendingLine struct {
+	end      int64
+	finished bool
+}
+
+// message saves the offset following its line when finished without error.
+type message struct {
+	binding.Message
+	commit func() error
+}
+
+func (m *message) Finish(err error) error {
+	finishErr := m.Message.Finish(err)
+	if err == nil {
+		if commitErr := m.commit(); commitErr != nil {
+			return commitErr
+		}
+	}
+	return finishErr
+}
+
+var _ protocol.Receiver = (*Receiver)(nil)
+var _ protocol.Closer = (*Receiver)(nil)
@@ -0,0 +1,183 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package tail
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+)
+
+func line(id string) string {
+	return fmt.Sprintf(`{"specversion":"1.0","id":%q,"source":"example/uri","type":"example.type"}`+"\n", id)
+}
+
+func appendLines(t *testing.T, path string, lines ...string) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	require.NoError(t, err)
+	for _, l := range lines {
+		_, err := f.WriteString(l)
+		require.NoError(t, err)
+	}
+	require.NoError(t, f.Close())
+}
+
+func receiveID(t *testing.T, r *Receiver) string {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	m, err := r.Receive(ctx)
+	require.NoError(t, err)
+	e, err := binding.ToEvent(ctx, m)
+	require.NoError(t, err)
+	require.NoError(t, m.Finish(nil))
+	return e.ID()
+}
+
+func TestReceiver(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.log")
+	r := NewReceiver(path, WithPollInterval(10*time.Millisecond))
+	defer r.Close(context.Background())
+
+	// the file is created after the receiver starts
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		appendLines(t, path, line("1"), "\n", line("2"))
+	}()
+	require.Equal(t, "1", receiveID(t, r))
+	require.Equal(t, "2", receiveID(t, r))
+
+	// a line is returned only once complete
+	l := line("3")
+	appendLines(t, path, l[:10])
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		appendLines(t, path, l[10:])
+	}()
+	require.Equal(t, "3", receiveID(t, r))
+}
+
+func TestReceiver_Rotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.log")
+	appendLines(t, path, line("1"))
+	r := NewReceiver(path, WithPollInterval(10*time.Millisecond))
+	defer r.Close(context.Background())
+	require.Equal(t, "1", receiveID(t, r))
+
+	appendLines(t, path, line("2"))
+	require.NoError(t, os.Rename(path, path+".1"))
+	appendLines(t, path, line("3"))
+
+	require.Equal(t, "2", receiveID(t, r))
+	require.Equal(t, "3", receiveID(t, r))
+}
+
+func TestReceiver_Truncation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.log")
+	appendLines(t, path, line("1"), line("2"))
+	r := NewReceiver(path, WithPollInterval(10*time.Millisecond))
+	defer r.Close(context.Background())
+	require.Equal(t, "1", receiveID(t, r))
+	require.Equal(t, "2", receiveID(t, r))
+
+	require.NoError(t, os.Truncate(path, 0))
+	appendLines(t, path, line("3"))
+	require.Equal(t, "3", receiveID(t, r))
+}
+
+func TestReceiver_ResumeFromOffset(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "events.log")
+	store := NewFileOffsetStore(filepath.Join(dir, "events.offset"))
+	appendLines(t, path, line("1"), line("2"), line("3"))
+
+	r := NewReceiver(path, WithOffsetStore(store), WithPollInterval(10*time.Millisecond))
+	require.Equal(t, "1", receiveID(t, r))
+
+	// a message finished with an error doesn't move the offset
+	m, err := r.Receive(context.Background())
+	require.NoError(t, err)
+	require.Error(t, m.Finish(fmt.Errorf("failed")))
+	require.NoError(t, r.Close(context.Background()))
+
+	offset, err := store.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(len(line("1"))), offset)
+
+	r = NewReceiver(path, WithOffsetStore(store), WithPollInterval(10*time.Millisecond))
+	defer r.Close(context.Background())
+	require.Equal(t, "2", receiveID(t, r))
+	require.Equal(t, "3", receiveID(t, r))
+
+	offset, err = store.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(3*len(line("1"))), offset)
+}
+
+func TestReceiver_ResumeAfterOutOfOrderFinish(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "events.log")
+	store := NewFileOffsetStore(filepath.Join(dir, "events.offset"))
+	appendLines(t, path, line("1"), line("2"), line("3"), line("4"))
+
+	r := NewReceiver(path, WithOffsetStore(store), WithPollInterval(10*time.Millisecond))
+	var msgs []binding.Message
+	for i := 0; i < 4; i++ {
+		m, err := r.Receive(context.Background())
+		require.NoError(t, err)
+		msgs = append(msgs, m)
+	}
+	// 3 is finished before 1 and 2, 2 fails and 4 is still being handled
+	require.NoError(t, msgs[2].Finish(nil))
+	require.NoError(t, msgs[0].Finish(nil))
+	require.Error(t, msgs[1].Finish(fmt.Errorf("failed")))
+	require.NoError(t, r.Close(context.Background()))
+
+	offset, err := store.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(len(line("1"))), offset)
+
+	r = NewReceiver(path, WithOffsetStore(store), WithPollInterval(10*time.Millisecond))
+	defer r.Close(context.Background())
+	require.Equal(t, "2", receiveID(t, r))
+	require.Equal(t, "3", receiveID(t, r))
+	require.Equal(t, "4", receiveID(t, r))
+}
+
+func TestReceiver_ResumeAfterTruncation(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "events.log")
+	store := NewFileOffsetStore(filepath.Join(dir, "events.offset"))
+	require.NoError(t, store.Save(1000))
+	appendLines(t, path, line("1"))
+
+	r := NewReceiver(path, WithOffsetStore(store), WithPollInterval(10*time.Millisecond))
+	defer r.Close(context.Background())
+	require.Equal(t, "1", receiveID(t, r))
+}
+
+func TestReceiver_Done(t *testing.T) {
+	r := NewReceiver(filepath.Join(t.TempDir(), "events.log"), WithPollInterval(10*time.Millisecond))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := r.Receive(ctx)
+	require.Equal(t, io.EOF, err)
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		_ = r.Close(context.Background())
+	}()
+	_, err = r.Receive(context.Background())
+	require.Equal(t, io.EOF, err)
+}
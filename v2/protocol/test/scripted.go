@@ -0,0 +1,102 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package test
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/binding/buffering"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+)
+
+// ReceiveResult is the outcome of a Receive call of a ScriptedReceiver:
+// either a Message or an Err, e.g. io.EOF.
+type ReceiveResult struct {
+	Message binding.Message
+	Err     error
+}
+
+// ScriptedReceiver is a protocol.Receiver returning a fixed script of messages
+// and errors, in order, across successive Receive calls, to test the handling
+// of errors and io.EOF without a broker.
+// Once the script is exhausted, Receive returns io.EOF.
+type ScriptedReceiver struct {
+	mu     sync.Mutex
+	script []ReceiveResult
+}
+
+// NewScriptedReceiver returns a ScriptedReceiver playing script.
+func NewScriptedReceiver(script []ReceiveResult) *ScriptedReceiver {
+	return &ScriptedReceiver{script: script}
+}
+
+func (r *ScriptedReceiver) Receive(ctx context.Context) (binding.Message, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.script) == 0 {
+		return nil, io.EOF
+	}
+	next := r.script[0]
+	r.script = r.script[1:]
+	return next.Message, next.Err
+}
+
+// Remaining returns the number of results of the script not received yet.
+func (r *ScriptedReceiver) Remaining() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.script)
+}
+
+// ScriptedSender is a protocol.Sender recording the sent messages.
+// Send returns the errors of the script in order, if any, then nil.
+// A message whose Send returns an error is not recorded.
+type ScriptedSender struct {
+	mu     sync.Mutex
+	script []error
+	sent   []binding.Message
+}
+
+// NewScriptedSender returns a ScriptedSender, whose successive Send calls return errs.
+func NewScriptedSender(errs ...error) *ScriptedSender {
+	return &ScriptedSender{script: errs}
+}
+
+// Send records a copy of m, with the transformers applied, and finishes m.
+func (s *ScriptedSender) Send(ctx context.Context, m binding.Message, transformers ...binding.Transformer) (err error) {
+	defer func() { _ = m.Finish(err) }()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.script) > 0 {
+		err = s.script[0]
+		s.script = s.script[1:]
+		if err != nil {
+			return err
+		}
+	}
+	copied, err := buffering.CopyMessage(ctx, m, transformers...)
+	if err != nil {
+		return err
+	}
+	s.sent = append(s.sent, copied)
+	return nil
+}
+
+// Sent returns the messages sent so far.
+func (s *ScriptedSender) Sent() []binding.Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]binding.Message(nil), s.sent...)
+}
+
+var _ protocol.Receiver = (*ScriptedReceiver)(nil)
+var _ protocol.Sender = (*ScriptedSender)(nil)
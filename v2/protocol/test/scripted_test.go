@@ -0,0 +1,56 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package test_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	. "github.com/cloudevents/sdk-go/v2/protocol/test"
+	. "github.com/cloudevents/sdk-go/v2/test"
+)
+
+func TestScriptedReceiver(t *testing.T) {
+	ctx := context.Background()
+	e := FullEvent()
+	m := binding.ToMessage(&e)
+	errDisconnected := errors.New("disconnected")
+
+	r := NewScriptedReceiver([]ReceiveResult{{Message: m}, {Err: errDisconnected}, {Message: m}, {Err: io.EOF}})
+	for _, want := range []ReceiveResult{{Message: m}, {Err: errDisconnected}, {Message: m}, {Err: io.EOF}, {Err: io.EOF}} {
+		got, err := r.Receive(ctx)
+		require.Equal(t, want.Message, got)
+		require.Equal(t, want.Err, err)
+	}
+	require.Equal(t, 0, r.Remaining())
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err := NewScriptedReceiver([]ReceiveResult{{Message: m}}).Receive(canceled)
+	require.Equal(t, context.Canceled, err)
+}
+
+func TestScriptedSender(t *testing.T) {
+	ctx := context.Background()
+	errUnavailable := errors.New("unavailable")
+	s := NewScriptedSender(errUnavailable)
+
+	e := FullEvent()
+	require.Equal(t, errUnavailable, s.Send(ctx, binding.ToMessage(&e)))
+	require.Empty(t, s.Sent())
+
+	require.NoError(t, s.Send(ctx, binding.ToMessage(&e)))
+	sent := s.Sent()
+	require.Len(t, sent, 1)
+	got, err := binding.ToEvent(ctx, sent[0])
+	require.NoError(t, err)
+	AssertEventEquals(t, e, *got)
+}
@@ -0,0 +1,10 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+/*
+Package writer implements a sender writing the events to an io.Writer, one per
+line, e.g. to print them to the standard output for debugging.
+*/
+package writer
@@ -0,0 +1,58 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package writer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/binding/format"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+)
+
+// Sender writes each sent event to an io.Writer, encoded in a structured format
+// and followed by a newline. Concurrent sends don't interleave their writes.
+type Sender struct {
+	w      io.Writer
+	format format.Format
+
+	mu sync.Mutex
+}
+
+// NewSender creates a Sender writing the events to w, encoded with f.
+// If f is nil, the events are encoded with format.JSON, one JSON object per line.
+func NewSender(w io.Writer, f format.Format) *Sender {
+	if f == nil {
+		f = format.JSON
+	}
+	return &Sender{w: w, format: f}
+}
+
+func (s *Sender) Send(ctx context.Context, m binding.Message, transformers ...binding.Transformer) (err error) {
+	if m == nil {
+		return fmt.Errorf("nil Message")
+	}
+	defer func() { _ = m.Finish(err) }()
+
+	e, err := binding.ToEvent(ctx, m, transformers...)
+	if err != nil {
+		return err
+	}
+	b, err := s.format.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(append(b, '\n'))
+	return err
+}
+
+var _ protocol.Sender = (*Sender)(nil)
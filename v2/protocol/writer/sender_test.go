@@ -0,0 +1,54 @@
+/*
+ Copyright 2026 The CloudEvents Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package writer
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/binding/format"
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/test"
+)
+
+func TestSender(t *testing.T) {
+	var buf bytes.Buffer
+	s := NewSender(&buf, nil)
+
+	events := []event.Event{test.FullEvent(), test.MinEvent()}
+	for i := range events {
+		require.NoError(t, s.Send(context.Background(), binding.ToMessage(&events[i])))
+	}
+
+	scanner := bufio.NewScanner(&buf)
+	for _, want := range events {
+		require.True(t, scanner.Scan())
+		b, err := format.JSON.Marshal(&want)
+		require.NoError(t, err)
+		require.JSONEq(t, string(b), scanner.Text())
+	}
+	require.False(t, scanner.Scan())
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("disk full") }
+
+func TestSender_WriteError(t *testing.T) {
+	e := test.MinEvent()
+	var finished error
+	m := binding.WithFinish(binding.ToMessage(&e), func(err error) { finished = err })
+
+	err := NewSender(failingWriter{}, format.JSON).Send(context.Background(), m)
+	require.EqualError(t, err, "disk full")
+	require.Equal(t, err, finished)
+}
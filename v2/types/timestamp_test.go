@@ -44,6 +44,63 @@ func TestTimestampParseString(t *testing.T) {
 	bad("2019-02-28", "cannot convert \"2019-02-28\" to time.Time: not in RFC3339 format")
 }
 
+func TestTimestampParseTolerance(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in   string
+		want time.Time
+		out  string
+	}{
+		"seconds": {
+			in:   "2019-02-28T15:04:05Z",
+			want: time.Date(2019, 02, 28, 15, 04, 05, 0, time.UTC),
+			out:  "2019-02-28T15:04:05Z",
+		},
+		"nanoseconds": {
+			in:   "2019-02-28T15:04:05.123456789Z",
+			want: time.Date(2019, 02, 28, 15, 04, 05, 123456789, time.UTC),
+			out:  "2019-02-28T15:04:05.123456789Z",
+		},
+		"zero offset": {
+			in:   "2019-02-28T15:04:05+00:00",
+			want: time.Date(2019, 02, 28, 15, 04, 05, 0, time.UTC),
+			out:  "2019-02-28T15:04:05Z",
+		},
+		"offset": {
+			in:   "2019-02-28T17:04:05.5+02:00",
+			want: time.Date(2019, 02, 28, 15, 04, 05, 500000000, time.UTC),
+			out:  "2019-02-28T15:04:05.5Z",
+		},
+		"no time zone": {
+			in:   "2019-02-28T15:04:05",
+			want: time.Date(2019, 02, 28, 15, 04, 05, 0, time.UTC),
+			out:  "2019-02-28T15:04:05Z",
+		},
+		"no time zone with nanoseconds": {
+			in:   "2019-02-28T15:04:05.123456789",
+			want: time.Date(2019, 02, 28, 15, 04, 05, 123456789, time.UTC),
+			out:  "2019-02-28T15:04:05.123456789Z",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := types.ParseTimestamp(tc.in)
+			require.NoError(t, err)
+			assert.True(t, tc.want.Equal(got.Time), "got %v, want %v", got.Time, tc.want)
+			assert.Equal(t, tc.out, got.String())
+
+			var ts types.Timestamp
+			require.NoError(t, json.Unmarshal([]byte(fmt.Sprintf("%q", tc.in)), &ts))
+			assert.True(t, tc.want.Equal(ts.Time), "got %v, want %v", ts.Time, tc.want)
+			b, err := json.Marshal(&ts)
+			require.NoError(t, err)
+			assert.Equal(t, fmt.Sprintf("%q", tc.out), string(b))
+
+			tt, err := types.ToTime(tc.in)
+			require.NoError(t, err)
+			assert.True(t, tc.want.Equal(tt), "got %v, want %v", tt, tc.want)
+		})
+	}
+}
+
 func TestJsonMarshalUnmarshalTimestamp(t *testing.T) {
 	ok := func(ts string) {
 		t.Helper()
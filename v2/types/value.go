@@ -7,6 +7,8 @@ package types
 
 import (
 	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"net/url"
@@ -46,9 +48,13 @@ func ParseInteger(v string) (int32, error) {
 // ParseBinary parse canonical string format: standard base64 encoding
 func ParseBinary(v string) ([]byte, error) { return base64.StdEncoding.DecodeString(v) }
 
-// ParseTime parse canonical string format: RFC3339 with nanoseconds
+// localRFC3339Nano is RFC3339 with nanoseconds and without the time zone.
+const localRFC3339Nano = "2006-01-02T15:04:05.999999999"
+
+// ParseTime parse canonical string format: RFC3339 with optional nanoseconds.
+// A time without time zone is accepted as UTC.
 func ParseTime(v string) (time.Time, error) {
-	t, err := time.Parse(time.RFC3339Nano, v)
+	t, err := parseTime(v)
 	if err != nil {
 		err := convertErr(time.Time{}, v)
 		err.extra = ": not in RFC3339 format"
@@ -57,6 +63,18 @@ func ParseTime(v string) (time.Time, error) {
 	return t, nil
 }
 
+// parseTime parses v as RFC3339 or, without time zone, as UTC. When v is
+// neither, the error of the RFC3339 layout is returned.
+func parseTime(v string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, v)
+	if err != nil {
+		if t, lerr := time.ParseInLocation(localRFC3339Nano, v, time.UTC); lerr == nil {
+			return t, nil
+		}
+	}
+	return t, err
+}
+
 // Format returns the canonical string format of v, where v can be
 // any type that is convertible to a CloudEvents type.
 func Format(v interface{}) (string, error) {
@@ -110,6 +128,21 @@ func Validate(v interface{}) (interface{}, error) {
 			return nil, rangeErr(v)
 		}
 		return int32(f), nil
+	case json.Number:
+		i, err := v.Int64()
+		switch {
+		case errors.Is(err, strconv.ErrRange), err == nil && (i > math.MaxInt32 || i < math.MinInt32):
+			// Too large for a CloudEvents Integer: keep the exact digits
+			// rather than rounding them through float64.
+			return v.String(), nil
+		case err == nil:
+			return int32(i), nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return nil, convertErr(int32(0), v)
+		}
+		return Validate(f)
 
 	case *url.URL:
 		if v == nil {
@@ -292,7 +325,7 @@ func ToTime(v interface{}) (time.Time, error) {
 	case Timestamp:
 		return v.Time, nil
 	case string:
-		ts, err := time.Parse(time.RFC3339Nano, v)
+		ts, err := parseTime(v)
 		if err != nil {
 			return time.Time{}, err
 		}
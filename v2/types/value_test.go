@@ -6,6 +6,7 @@
 package types_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"net/url"
@@ -152,6 +153,8 @@ func TestInteger(t *testing.T) {
 	x.ok(uint64(64), int32(64), "64")
 	x.ok(float32(123.4), int32(123), "123")
 	x.ok(float64(-567.8), int32(-567), "-567")
+	x.ok(json.Number("42"), int32(42), "42")
+	x.ok(json.Number("-567.8"), int32(-567), "-567")
 	i := new(uint16)
 	*i = 24 // non-nil pointers allowed
 	x.ok(i, int32(24), "24")
@@ -191,6 +194,8 @@ func TestString(t *testing.T) {
 	s := new(string)
 	*s = "foo" // non-nil pointers allowed
 	x.ok(s, "foo", "foo")
+	// Integers too large for int32 keep their exact digits.
+	x.ok(json.Number("9007199254740993"), "9007199254740993", "9007199254740993")
 
 	x.err(map[string]string{"totes": "error"}, "invalid CloudEvents value: map[string]string{\"totes\":\"error\"}")
 }